@@ -0,0 +1,65 @@
+package company
+
+import (
+	"context"
+	"domain/company/entity"
+	"domain/company/repository"
+	vacancyEntity "domain/vacancy/entity"
+	"fmt"
+	"infrastructure/company"
+	"strings"
+	"time"
+)
+
+// Service links vacancies to the companies offering them, merging the spellings of the same employer.
+type Service struct {
+	repository repository.CompanyRepository // Repository storing the companies.
+	normalizer *company.Normalizer          // Normalizer reducing company names to their key.
+}
+
+// NewService creates and returns a new Service instance.
+func NewService(repository repository.CompanyRepository, normalizer *company.Normalizer) *Service {
+	return &Service{repository: repository, normalizer: normalizer}
+}
+
+// Resolve records that the company named name was seen at seenAt and returns the company.
+// It returns nil for names that do not identify any company, such as an empty one or a placeholder.
+func (s *Service) Resolve(ctx context.Context, name string, seenAt time.Time) (*entity.Company, error) {
+	name = strings.TrimSpace(name)
+	key := s.normalizer.Key(name)
+	if key == "" || vacancyEntity.IsPlaceholder(name) {
+		return nil, nil
+	}
+
+	company, err := s.repository.Upsert(ctx, key, name, seenAt)
+	if err != nil {
+		return nil, fmt.Errorf("upsert company %q: %w", key, err)
+	}
+	return company, nil
+}
+
+// CountVacancy adds a newly stored vacancy to the count of the company, which may be nil.
+func (s *Service) CountVacancy(ctx context.Context, company *entity.Company) error {
+	if company == nil {
+		return nil
+	}
+	if err := s.repository.IncrementVacancies(ctx, company.Key); err != nil {
+		return fmt.Errorf("increment vacancies of company %q: %w", company.Key, err)
+	}
+	return nil
+}
+
+// SetStatus blocks or allows the vacancies of the company named name, under any of its spellings.
+func (s *Service) SetStatus(ctx context.Context, name string, status entity.Status) (*entity.Company, error) {
+	name = strings.TrimSpace(name)
+	key := s.normalizer.Key(name)
+	if key == "" {
+		return nil, fmt.Errorf("company name %q has no key", name)
+	}
+
+	company, err := s.repository.SetStatus(ctx, key, name, status)
+	if err != nil {
+		return nil, fmt.Errorf("set status of company %q: %w", key, err)
+	}
+	return company, nil
+}
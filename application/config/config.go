@@ -1,15 +1,21 @@
 package config
 
 import (
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds the main application configuration settings.
 type Config struct {
 	Proxy         ProxyConfig         // Proxy configuration.
 	Mongo         MongoDBConfig       // MongoDB configuration.
+	Snapshot      SnapshotConfig      // Raw page snapshot store configuration.
 	SourceHandler SourceHandlerConfig // Source handler configuration.
+	Salary        SalaryConfig        // Salary normalisation configuration.
+	Skills        SkillsConfig        // Skills tagging configuration.
 	AuthServer    AuthServerConfig    // AuthServer holds configuration details for the Auth service.
 	VacancyServer VacancyServerConfig // VacancyServer holds configuration details for the Vacancy service.
 	Env           string              // Environment type (e.g., dev, prod).
@@ -35,28 +41,96 @@ type ProxyConfig struct {
 	PingUrl         string // PingUrl is the URL used to check the proxy's status or connectivity.
 }
 
+// SnapshotConfig holds configuration settings for the raw page snapshot store.
+type SnapshotConfig struct {
+	Dir       string        // Dir is the directory snapshots are stored in.
+	Retention time.Duration // Retention is how long snapshots are kept before they are purged.
+}
+
+// SalaryConfig holds configuration settings for salary normalisation.
+type SalaryConfig struct {
+	Currency string             // Currency is the ISO 4217 code of the currency annual salaries are converted to.
+	Rates    map[string]float64 // Rates is the value of one unit of each currency in the reference currency.
+}
+
+// SkillsConfig holds configuration settings for skills tagging.
+type SkillsConfig struct {
+	Taxonomy string // Taxonomy is the path of the skills taxonomy JSON file, empty uses the embedded taxonomy.
+}
+
 // MongoDBConfig holds configuration settings for MongoDB.
 type MongoDBConfig struct {
-	Host              string // Host is the hostname or IP address of the MongoDB server.
-	Port              string // Port is the port number of the MongoDB server.
-	User              string // User is the username for connecting to the MongoDB server.
-	Pass              string // Pass is the password for connecting to the MongoDB server.
-	DB                string // DB is the name of the MongoDB database.
-	UrlsCollection    string // UrlsCollection is the name of the MongoDB collection.
-	VacancyCollection string // VacancyCollection is the name of the MongoDB collection.
+	Host               string // Host is the hostname or IP address of the MongoDB server.
+	Port               string // Port is the port number of the MongoDB server.
+	User               string // User is the username for connecting to the MongoDB server.
+	Pass               string // Pass is the password for connecting to the MongoDB server.
+	DB                 string // DB is the name of the MongoDB database.
+	UrlsCollection     string // UrlsCollection is the name of the MongoDB collection.
+	VacancyCollection  string // VacancyCollection is the name of the MongoDB collection.
+	RunsCollection     string // RunsCollection is the name of the MongoDB collection storing run reports.
+	CoverageCollection string // CoverageCollection is the name of the MongoDB collection storing batch field coverage.
+	CompanyCollection  string // CompanyCollection is the name of the MongoDB collection storing companies.
 }
 
 // SourceHandlerConfig holds configuration settings for Source Handlers.
 type SourceHandlerConfig struct {
-	Alfa      SourceConfig // Alfa source handler configuration.
-	Beta      SourceConfig // Beta source handler configuration.
-	Gamma     SourceConfig // Gamma source handler configuration.
-	BatchSize int          // BatchSize is the batch size for processing.
+	Alfa        SourceConfig  // Alfa source handler configuration.
+	Beta        SourceConfig  // Beta source handler configuration.
+	Gamma       SourceConfig  // Gamma source handler configuration.
+	BatchSize   int           // BatchSize is the batch size for processing.
+	Timeout     time.Duration // Timeout is the maximum time a single source may run before it is abandoned.
+	MaxRequests int           // MaxRequests is the global cap on simultaneous outbound requests across all sources.
 }
 
 // SourceConfig represents configuration for a single source.
 type SourceConfig struct {
-	SitemapURL string // URL of the sitemap or RSS feed.
+	SitemapURL   string          // URL of the sitemap or RSS feed.
+	Concurrency  int             // Concurrency is the number of URLs of the source processed in parallel.
+	Interval     time.Duration   // Interval is the time between the starts of two crawls of the source in daemon mode.
+	Jitter       time.Duration   // Jitter is the maximum random delay added to each interval.
+	ActiveHours  string          // ActiveHours restricts crawls to a daily window (e.g., "08-20"), empty means always.
+	DrainTimeout time.Duration   // DrainTimeout is how long in-flight URLs may keep running after shutdown is requested.
+	BatchDelay   time.Duration   // BatchDelay is the pause between two batches of URLs.
+	DelistAfter  int             // DelistAfter is the number of runs missing a URL before it is delisted, 0 disables it.
+	RecrawlAfter time.Duration   // RecrawlAfter is the age at which processed URLs are fetched again, 0 disables it.
+	RateLimit    RateLimitConfig // RateLimit is the politeness policy applied to the host of the source.
+	Drift        DriftConfig     // Drift is the parser drift detection applied to the batches of the source.
+	Language     LanguageConfig  // Language decides which vacancies of the source are kept by their language.
+}
+
+// Hosts returns the host of the sitemap URL followed by the further hosts the postings of the source are
+// served from.
+func (c SourceConfig) Hosts() []string {
+	var hosts []string
+	if u, err := url.Parse(c.SitemapURL); err == nil && u.Host != "" {
+		hosts = append(hosts, u.Host)
+	}
+	return append(hosts, c.RateLimit.Hosts...)
+}
+
+// RateLimitConfig represents the per-host politeness policy of a source.
+type RateLimitConfig struct {
+	RequestsPerMinute int           // RequestsPerMinute is the sustained number of requests sent to the host per minute.
+	Burst             int           // Burst is the number of requests that may be sent back to back.
+	MinDelay          time.Duration // MinDelay is the minimum time between two requests to the host.
+	RespectCrawlDelay bool          // RespectCrawlDelay honours the Crawl-delay announced in the host's robots.txt.
+	Hosts             []string      // Hosts lists further hosts the postings of the source are served from.
+}
+
+// LanguageConfig represents the language rules of a source.
+// Vacancies whose language is unknown or detected with less than MinConfidence are always kept.
+type LanguageConfig struct {
+	Allow         []string // Allow lists the ISO 639-1 codes of the languages kept, empty means all languages.
+	Deny          []string // Deny lists the ISO 639-1 codes of the languages dropped.
+	MinConfidence float64  // MinConfidence is the detection confidence, from 0 to 1, required to drop a vacancy.
+}
+
+// DriftConfig represents the parser drift detection of a source.
+type DriftConfig struct {
+	Window     int           // Window is the number of recent batches the coverage baseline is averaged over.
+	MinSamples int           // MinSamples is the minimum number of parsed pages a batch needs to be compared.
+	Threshold  float64       // Threshold is the drop in field coverage, from 0 to 1, that pauses the source.
+	Pause      time.Duration // Pause is how long HTML processing of the source is paused after drift.
 }
 
 // LoadConfig loads the configuration settings from environment variables, falling back to default values.
@@ -70,25 +144,121 @@ func LoadConfig() *Config {
 			PingUrl:         getEnv("PROXY_PING_URL", ""),
 		},
 		Mongo: MongoDBConfig{
-			Host:              getEnv("MONGO_HOST", ""),
-			Port:              getEnv("MONGO_PORT", ""),
-			User:              getEnv("MONGO_USER", ""),
-			Pass:              getEnv("MONGO_PASS", ""),
-			DB:                getEnv("MONGO_DB", ""),
-			UrlsCollection:    getEnv("MONGO_URLS_COLLECTION", ""),
-			VacancyCollection: getEnv("MONGO_VACANCY_COLLECTION", ""),
+			Host:               getEnv("MONGO_HOST", ""),
+			Port:               getEnv("MONGO_PORT", ""),
+			User:               getEnv("MONGO_USER", ""),
+			Pass:               getEnv("MONGO_PASS", ""),
+			DB:                 getEnv("MONGO_DB", ""),
+			UrlsCollection:     getEnv("MONGO_URLS_COLLECTION", ""),
+			VacancyCollection:  getEnv("MONGO_VACANCY_COLLECTION", ""),
+			RunsCollection:     getEnv("MONGO_RUNS_COLLECTION", "runs"),
+			CoverageCollection: getEnv("MONGO_COVERAGE_COLLECTION", "coverage"),
+			CompanyCollection:  getEnv("MONGO_COMPANY_COLLECTION", "companies"),
+		},
+		Snapshot: SnapshotConfig{
+			Dir:       getEnv("SNAPSHOT_DIR", "/var/lib/pulse-finder/snapshots"),
+			Retention: getEnvAsDuration("SNAPSHOT_RETENTION", 30*24*time.Hour),
+		},
+		Salary: SalaryConfig{
+			Currency: getEnv("SALARY_CURRENCY", "EUR"),
+			Rates: getEnvAsRates("SALARY_RATES", map[string]float64{
+				"USD": 0.92, "GBP": 1.17, "CHF": 1.04, "PLN": 0.23, "CZK": 0.04, "UAH": 0.024,
+			}),
+		},
+		Skills: SkillsConfig{
+			Taxonomy: getEnv("SKILLS_TAXONOMY", ""),
 		},
 		SourceHandler: SourceHandlerConfig{
 			Alfa: SourceConfig{
-				SitemapURL: getEnv("SOURCE_ALFA_SITEMAP_URL", "example.com"),
+				SitemapURL:   getEnv("SOURCE_ALFA_SITEMAP_URL", "example.com"),
+				Concurrency:  getEnvAsInt("SOURCE_ALFA_CONCURRENCY", 5),
+				Interval:     getEnvAsDuration("SOURCE_ALFA_INTERVAL", 6*time.Hour),
+				Jitter:       getEnvAsDuration("SOURCE_ALFA_JITTER", 10*time.Minute),
+				ActiveHours:  getEnv("SOURCE_ALFA_ACTIVE_HOURS", ""),
+				DrainTimeout: getEnvAsDuration("SOURCE_ALFA_DRAIN_TIMEOUT", 30*time.Second),
+				BatchDelay:   getEnvAsDuration("SOURCE_ALFA_BATCH_DELAY", 15*time.Second),
+				DelistAfter:  getEnvAsInt("SOURCE_ALFA_DELIST_AFTER", 0),
+				RecrawlAfter: getEnvAsDuration("SOURCE_ALFA_RECRAWL_AFTER", 24*time.Hour),
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: getEnvAsInt("SOURCE_ALFA_REQUESTS_PER_MINUTE", 30),
+					Burst:             getEnvAsInt("SOURCE_ALFA_BURST", 5),
+					MinDelay:          getEnvAsDuration("SOURCE_ALFA_MIN_DELAY", time.Second),
+					RespectCrawlDelay: getEnvAsBool("SOURCE_ALFA_RESPECT_CRAWL_DELAY", true),
+					Hosts:             getEnvAsList("SOURCE_ALFA_HOSTS", nil),
+				},
+				Drift: DriftConfig{
+					Window:     getEnvAsInt("SOURCE_ALFA_DRIFT_WINDOW", 20),
+					MinSamples: getEnvAsInt("SOURCE_ALFA_DRIFT_MIN_SAMPLES", 5),
+					Threshold:  getEnvAsFloat("SOURCE_ALFA_DRIFT_THRESHOLD", 0.5),
+					Pause:      getEnvAsDuration("SOURCE_ALFA_DRIFT_PAUSE", 24*time.Hour),
+				},
+				Language: LanguageConfig{
+					Allow:         getEnvAsList("SOURCE_ALFA_LANGUAGE_ALLOW", nil),
+					Deny:          getEnvAsList("SOURCE_ALFA_LANGUAGE_DENY", nil),
+					MinConfidence: getEnvAsFloat("SOURCE_ALFA_LANGUAGE_MIN_CONFIDENCE", 0.8),
+				},
 			},
 			Beta: SourceConfig{
-				SitemapURL: getEnv("SOURCE_BETA_SITEMAP_URL", ""),
+				SitemapURL:   getEnv("SOURCE_BETA_SITEMAP_URL", ""),
+				Concurrency:  getEnvAsInt("SOURCE_BETA_CONCURRENCY", 5),
+				Interval:     getEnvAsDuration("SOURCE_BETA_INTERVAL", 6*time.Hour),
+				Jitter:       getEnvAsDuration("SOURCE_BETA_JITTER", 10*time.Minute),
+				ActiveHours:  getEnv("SOURCE_BETA_ACTIVE_HOURS", ""),
+				DrainTimeout: getEnvAsDuration("SOURCE_BETA_DRAIN_TIMEOUT", 30*time.Second),
+				BatchDelay:   getEnvAsDuration("SOURCE_BETA_BATCH_DELAY", 15*time.Second),
+				DelistAfter:  getEnvAsInt("SOURCE_BETA_DELIST_AFTER", 3),
+				RecrawlAfter: getEnvAsDuration("SOURCE_BETA_RECRAWL_AFTER", 24*time.Hour),
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: getEnvAsInt("SOURCE_BETA_REQUESTS_PER_MINUTE", 30),
+					Burst:             getEnvAsInt("SOURCE_BETA_BURST", 5),
+					MinDelay:          getEnvAsDuration("SOURCE_BETA_MIN_DELAY", time.Second),
+					RespectCrawlDelay: getEnvAsBool("SOURCE_BETA_RESPECT_CRAWL_DELAY", true),
+					Hosts:             getEnvAsList("SOURCE_BETA_HOSTS", nil),
+				},
+				Drift: DriftConfig{
+					Window:     getEnvAsInt("SOURCE_BETA_DRIFT_WINDOW", 20),
+					MinSamples: getEnvAsInt("SOURCE_BETA_DRIFT_MIN_SAMPLES", 5),
+					Threshold:  getEnvAsFloat("SOURCE_BETA_DRIFT_THRESHOLD", 0.5),
+					Pause:      getEnvAsDuration("SOURCE_BETA_DRIFT_PAUSE", 24*time.Hour),
+				},
+				Language: LanguageConfig{
+					Allow:         getEnvAsList("SOURCE_BETA_LANGUAGE_ALLOW", nil),
+					Deny:          getEnvAsList("SOURCE_BETA_LANGUAGE_DENY", nil),
+					MinConfidence: getEnvAsFloat("SOURCE_BETA_LANGUAGE_MIN_CONFIDENCE", 0.8),
+				},
 			},
 			Gamma: SourceConfig{
-				SitemapURL: getEnv("SOURCE_GAMMA_SITEMAP_URL", ""),
+				SitemapURL:   getEnv("SOURCE_GAMMA_SITEMAP_URL", ""),
+				Concurrency:  getEnvAsInt("SOURCE_GAMMA_CONCURRENCY", 5),
+				Interval:     getEnvAsDuration("SOURCE_GAMMA_INTERVAL", 6*time.Hour),
+				Jitter:       getEnvAsDuration("SOURCE_GAMMA_JITTER", 10*time.Minute),
+				ActiveHours:  getEnv("SOURCE_GAMMA_ACTIVE_HOURS", ""),
+				DrainTimeout: getEnvAsDuration("SOURCE_GAMMA_DRAIN_TIMEOUT", 30*time.Second),
+				BatchDelay:   getEnvAsDuration("SOURCE_GAMMA_BATCH_DELAY", 15*time.Second),
+				DelistAfter:  getEnvAsInt("SOURCE_GAMMA_DELIST_AFTER", 3),
+				RecrawlAfter: getEnvAsDuration("SOURCE_GAMMA_RECRAWL_AFTER", 24*time.Hour),
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: getEnvAsInt("SOURCE_GAMMA_REQUESTS_PER_MINUTE", 30),
+					Burst:             getEnvAsInt("SOURCE_GAMMA_BURST", 5),
+					MinDelay:          getEnvAsDuration("SOURCE_GAMMA_MIN_DELAY", time.Second),
+					RespectCrawlDelay: getEnvAsBool("SOURCE_GAMMA_RESPECT_CRAWL_DELAY", true),
+					Hosts:             getEnvAsList("SOURCE_GAMMA_HOSTS", nil),
+				},
+				Drift: DriftConfig{
+					Window:     getEnvAsInt("SOURCE_GAMMA_DRIFT_WINDOW", 20),
+					MinSamples: getEnvAsInt("SOURCE_GAMMA_DRIFT_MIN_SAMPLES", 5),
+					Threshold:  getEnvAsFloat("SOURCE_GAMMA_DRIFT_THRESHOLD", 0.5),
+					Pause:      getEnvAsDuration("SOURCE_GAMMA_DRIFT_PAUSE", 24*time.Hour),
+				},
+				Language: LanguageConfig{
+					Allow:         getEnvAsList("SOURCE_GAMMA_LANGUAGE_ALLOW", nil),
+					Deny:          getEnvAsList("SOURCE_GAMMA_LANGUAGE_DENY", nil),
+					MinConfidence: getEnvAsFloat("SOURCE_GAMMA_LANGUAGE_MIN_CONFIDENCE", 0.8),
+				},
 			},
-			BatchSize: getEnvAsInt("SOURCE_BATCH_SIZE", 1),
+			BatchSize:   getEnvAsInt("SOURCE_BATCH_SIZE", 1),
+			Timeout:     getEnvAsDuration("SOURCE_TIMEOUT", 2*time.Hour),
+			MaxRequests: getEnvAsInt("SOURCE_MAX_REQUESTS", 10),
 		},
 		AuthServer: AuthServerConfig{
 			Address: getEnv("AUTH_SERVER_ADDRESS", ""),
@@ -119,3 +289,68 @@ func getEnvAsInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// getEnvAsDuration fetches the value of an environment variable as a time.Duration or returns a fallback.
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	v := getEnv(key, "")
+	if value, err := time.ParseDuration(v); err == nil {
+		return value
+	}
+	return fallback
+}
+
+// getEnvAsBool fetches the value of an environment variable as a boolean or returns a fallback.
+func getEnvAsBool(key string, fallback bool) bool {
+	v := getEnv(key, "")
+	if value, err := strconv.ParseBool(v); err == nil {
+		return value
+	}
+	return fallback
+}
+
+// getEnvAsFloat fetches the value of an environment variable as a float or returns a fallback.
+func getEnvAsFloat(key string, fallback float64) float64 {
+	v := getEnv(key, "")
+	if value, err := strconv.ParseFloat(v, 64); err == nil {
+		return value
+	}
+	return fallback
+}
+
+// getEnvAsList fetches the value of an environment variable as a comma-separated list (e.g., "en,pl")
+// or returns a fallback.
+func getEnvAsList(key string, fallback []string) []string {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback
+	}
+
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvAsRates fetches the value of an environment variable as exchange rates (e.g., "USD=0.92,PLN=0.23")
+// or returns a fallback. Malformed entries are skipped.
+func getEnvAsRates(key string, fallback map[string]float64) map[string]float64 {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback
+	}
+
+	rates := make(map[string]float64)
+	for _, entry := range strings.Split(v, ",") {
+		code, rate, ok := strings.Cut(entry, "=")
+		if !ok {
+			continue
+		}
+		if value, err := strconv.ParseFloat(strings.TrimSpace(rate), 64); err == nil {
+			rates[strings.ToUpper(strings.TrimSpace(code))] = value
+		}
+	}
+	return rates
+}
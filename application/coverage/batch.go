@@ -0,0 +1,81 @@
+package coverage
+
+import (
+	"application/url/processor/dto"
+	vacancyEntity "domain/vacancy/entity"
+	"strings"
+	"sync"
+)
+
+// Fields lists the vacancy fields whose coverage is tracked.
+var Fields = []string{"title", "company", "description", "location", "posted_at"}
+
+// Batch counts the fields extracted with a non-placeholder value across the pages of a batch.
+// It is safe for concurrent use, a nil Batch ignores all calls.
+type Batch struct {
+	mu      sync.Mutex
+	samples int            // samples is the number of parsed pages.
+	filled  map[string]int // filled is the number of pages a field was extracted from, by field.
+}
+
+// NewBatch creates an empty Batch.
+func NewBatch() *Batch {
+	return &Batch{filled: make(map[string]int, len(Fields))}
+}
+
+// Add records the fields extracted from a single page.
+// Posting dates only count when found on the page, not when the fetch time is used as a fallback.
+func (b *Batch) Add(v *dto.Vacancy) {
+	if b == nil || v == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.samples++
+	for field, ok := range map[string]bool{
+		"title":       extracted(v.Title),
+		"company":     extracted(v.Company),
+		"description": extracted(v.Description),
+		"location":    extracted(v.Location),
+		"posted_at":   v.PostedAtConfidence.Rank() > vacancyEntity.DateConfidenceNone.Rank(),
+	} {
+		if ok {
+			b.filled[field]++
+		}
+	}
+}
+
+// Samples returns the number of pages recorded.
+func (b *Batch) Samples() int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.samples
+}
+
+// Coverage returns the share of pages each tracked field was extracted from, from 0 to 1.
+func (b *Batch) Coverage() map[string]float64 {
+	coverage := make(map[string]float64, len(Fields))
+	if b == nil {
+		return coverage
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, field := range Fields {
+		if b.samples > 0 {
+			coverage[field] = float64(b.filled[field]) / float64(b.samples)
+		}
+	}
+	return coverage
+}
+
+// extracted reports whether value holds extracted data rather than nothing or a placeholder.
+func extracted(value string) bool {
+	return strings.TrimSpace(value) != "" && !vacancyEntity.IsPlaceholder(value)
+}
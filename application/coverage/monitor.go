@@ -0,0 +1,129 @@
+package coverage
+
+import (
+	"context"
+	"domain/coverage/entity"
+	"domain/coverage/repository"
+	"domain/html"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// minBaseline is the number of batches required before the baseline is trusted.
+const minBaseline = 3
+
+// ErrDrift indicates that the field coverage of a batch dropped sharply compared to the baseline.
+var ErrDrift = errors.New("parser drift")
+
+// Monitor compares the field coverage of every batch of a source against a rolling baseline.
+// When the coverage of a field drops sharply the source is paused, as the board most likely changed its layout.
+type Monitor struct {
+	repository repository.CoverageRepository // Repository storing the coverage of every batch.
+	source     string                        // Name of the monitored source.
+	window     int                           // Number of recent batches the baseline is averaged over.
+	minSamples int                           // Minimum number of pages a batch needs to be compared.
+	threshold  float64                       // Drop in coverage, from 0 to 1, that counts as drift.
+	pause      time.Duration                 // How long HTML processing is paused after drift.
+}
+
+// NewMonitor creates and returns a new Monitor instance.
+// A threshold of zero disables drift detection, coverage is still stored.
+func NewMonitor(
+	repository repository.CoverageRepository,
+	source string,
+	window, minSamples int,
+	threshold float64,
+	pause time.Duration,
+) *Monitor {
+	return &Monitor{
+		repository: repository,
+		source:     source,
+		window:     max(window, 1),
+		minSamples: max(minSamples, 1),
+		threshold:  threshold,
+		pause:      pause,
+	}
+}
+
+// Check stores the coverage of the batch and compares it against the baseline.
+// Batches that drifted pause the source and are kept out of the baseline, as are batches too small to compare.
+func (m *Monitor) Check(ctx context.Context, parser html.Parser, batch *Batch) (*entity.Coverage, error) {
+	if batch.Samples() == 0 {
+		return nil, nil
+	}
+
+	baseline, err := m.baseline(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch baseline: %w", err)
+	}
+
+	coverage := &entity.Coverage{
+		Source:        m.source,
+		Parser:        parser.Name(),
+		ParserVersion: parser.Version(),
+		CreatedAt:     time.Now(),
+		Samples:       batch.Samples(),
+		Sparse:        batch.Samples() < m.minSamples,
+		Fields:        batch.Coverage(),
+		Baseline:      baseline,
+	}
+	if m.threshold > 0 && !coverage.Sparse {
+		coverage.Drift = Drift(baseline, coverage.Fields, m.threshold)
+	}
+	if coverage.Drifted() {
+		coverage.PausedUntil = coverage.CreatedAt.Add(m.pause)
+		fmt.Printf("[WARN] parser drift on source %s, coverage dropped for %v, pausing until %s\n",
+			m.source, coverage.Drift, coverage.PausedUntil.Format(time.RFC3339))
+	}
+
+	if err = m.repository.Save(ctx, coverage); err != nil {
+		return nil, fmt.Errorf("save coverage: %w", err)
+	}
+	return coverage, nil
+}
+
+// PausedUntil returns the time HTML processing of the source is paused until, or the zero time if it is not.
+func (m *Monitor) PausedUntil(ctx context.Context) (time.Time, error) {
+	coverage, err := m.repository.FetchPause(ctx, m.source, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("fetch pause: %w", err)
+	}
+	if coverage == nil {
+		return time.Time{}, nil
+	}
+	return coverage.PausedUntil, nil
+}
+
+// baseline averages the coverage of the recent batches that did not drift and were not sparse.
+// Returns nil until enough batches are available.
+func (m *Monitor) baseline(ctx context.Context) (map[string]float64, error) {
+	recent, err := m.repository.FetchBaseline(ctx, m.source, m.window)
+	if err != nil {
+		return nil, err
+	}
+	if len(recent) < minBaseline {
+		return nil, nil
+	}
+
+	baseline := make(map[string]float64, len(Fields))
+	for _, coverage := range recent {
+		for _, field := range Fields {
+			baseline[field] += coverage.Fields[field] / float64(len(recent))
+		}
+	}
+	return baseline, nil
+}
+
+// Drift returns the fields whose coverage dropped by at least threshold compared to the baseline, sorted by name.
+func Drift(baseline, current map[string]float64, threshold float64) []string {
+	var fields []string
+	for field, base := range baseline {
+		if base-current[field] >= threshold {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
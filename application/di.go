@@ -1,7 +1,9 @@
 package application
 
 import (
+	"application/company"
 	"application/config"
+	"application/coverage"
 	"application/dependency"
 	"application/proxy/circuit"
 	"application/proxy/commands"
@@ -13,12 +15,26 @@ import (
 	sourceAlfa "application/source/alfa"
 	sourceBeta "application/source/beta"
 	"application/url/processor"
+	"application/url/processor/limiter"
 	"application/url/sitemap"
+	"application/vacancy/dedup"
+	"application/vacancy/enrich"
+	"application/vacancy/reparse"
+	"application/vacancy/store"
+	coverageRepository "domain/coverage/repository"
 	"domain/html"
 	"domain/scheduler"
+	"fmt"
 	"infrastructure"
+	companyNormalizer "infrastructure/company"
+	"infrastructure/geo"
+	"infrastructure/html/schema"
 	htmlAlfa "infrastructure/html/source/alfa"
 	htmlBeta "infrastructure/html/source/beta"
+	"infrastructure/http/ratelimit"
+	"infrastructure/language"
+	"infrastructure/salary"
+	"infrastructure/skills"
 	"infrastructure/url/sitemap/fetcher"
 	"infrastructure/url/sitemap/notifier"
 	"infrastructure/url/sitemap/parser"
@@ -43,6 +59,7 @@ type Container struct {
 	RetryStrategy           dependency.LazyDependency[strategies.RetryStrategy]
 	IdentityService         dependency.LazyDependency[*services.Identity]
 	CircuitManager          dependency.LazyDependency[*circuit.Manager]
+	Enrichers               dependency.LazyDependency[[]enrich.Enricher]
 	AlfaHtmlFetcher         dependency.LazyDependency[html.Fetcher]
 	AlfaHtmlParser          dependency.LazyDependency[html.Parser]
 	AlfaHandler             dependency.LazyDependency[*sourceAlfa.Handler]
@@ -51,11 +68,21 @@ type Container struct {
 	BetaHandler             dependency.LazyDependency[*sourceBeta.Handler]
 	SourceFactory           dependency.LazyDependency[*source.Factory]
 	ProcessorService        dependency.LazyDependency[*processor.Service]
+	RequestLimiter          dependency.LazyDependency[*limiter.Limiter]
+	HostLimiter             dependency.LazyDependency[*ratelimit.Limiter]
+	HostTransport           dependency.LazyDependency[*ratelimit.Transport]
+	HostClient              dependency.LazyDependency[*http.Client]
+	CompanyService          dependency.LazyDependency[*company.Service]
+	CompanyNormalizer       dependency.LazyDependency[*companyNormalizer.Normalizer]
+	DedupService            dependency.LazyDependency[*dedup.Service]
+	VacancyStore            dependency.LazyDependency[*store.Service]
+	ReparseService          dependency.LazyDependency[*reparse.Service]
 	AuthenticateCommand     dependency.LazyDependency[*control.AuthenticateCommand]
 	SignalCommand           dependency.LazyDependency[*control.SignalCommand]
 	StatusCommand           dependency.LazyDependency[*commands.StatusCommand]
 	InfrastructureContainer dependency.LazyDependency[*infrastructure.Container]
 	CronScheduler           dependency.LazyDependency[scheduler.Scheduler]
+	CrawlerScheduler        dependency.LazyDependency[scheduler.Scheduler]
 }
 
 // NewContainer creates and returns a new instance of Container.
@@ -128,66 +155,84 @@ func NewContainer() *Container {
 	}
 
 	// Parser services
+	c.Enrichers = dependency.LazyDependency[[]enrich.Enricher]{
+		InitFunc: func() []enrich.Enricher {
+			cfg := c.Config.Get()
+			taxonomy, err := skills.Load(cfg.Skills.Taxonomy)
+			if err != nil {
+				log.Fatalf("load skills taxonomy: %v", err)
+			}
+			gazetteer, err := geo.NewGazetteer()
+			if err != nil {
+				log.Fatalf("load gazetteer: %v", err)
+			}
+			detector, err := language.NewDetector()
+			if err != nil {
+				log.Fatalf("load language profiles: %v", err)
+			}
+			return []enrich.Enricher{
+				enrich.NewSalary(salary.NewNormalizer(cfg.Salary.Currency, cfg.Salary.Rates)),
+				enrich.NewSkills(skills.NewExtractor(taxonomy)),
+				enrich.NewClassifier(),
+				enrich.NewPlaces(geo.NewParser(gazetteer)),
+				enrich.NewLanguage(detector),
+			}
+		},
+	}
 	c.AlfaHtmlFetcher = dependency.LazyDependency[html.Fetcher]{
 		InitFunc: func() html.Fetcher {
-			var (
-				maxBodySize = int64(10 * 1024 * 1024) // 10MB
-				httpClient  *http.Client
-				err         error
-			)
-			if httpClient, err = c.ProxyService.Get().HttpClient(); err != nil {
-				log.Fatalf("get proxy http client: %v", err)
-			}
-			return htmlAlfa.NewFetcher(httpClient, maxBodySize)
+			maxBodySize := int64(10 * 1024 * 1024) // 10MB
+			return htmlAlfa.NewFetcher(c.HostClient.Get(), maxBodySize)
 		},
 	}
 	c.AlfaHtmlParser = dependency.LazyDependency[html.Parser]{
 		InitFunc: func() html.Parser {
-			return htmlAlfa.NewParser()
+			return enrich.NewParser(schema.NewParser(htmlAlfa.NewParser()), c.Enrichers.Get()...)
 		},
 	}
 	c.AlfaHandler = dependency.LazyDependency[*sourceAlfa.Handler]{
 		InitFunc: func() *sourceAlfa.Handler {
-			url := c.Config.Get().SourceHandler.Alfa.SitemapURL
+			cfg := c.Config.Get().SourceHandler.Alfa
 			sitemapService := c.SitemapServiceRSS.Get()
 			circuitManager := c.CircuitManager.Get()
 			urlRepository := c.InfrastructureContainer.Get().UrlRepository.Get()
-			vacancyRepository := c.InfrastructureContainer.Get().VacancyRepository.Get()
+			vacancyStore := c.VacancyStore.Get()
+			snapshotStore := c.InfrastructureContainer.Get().SnapshotStore.Get()
+			coverageRepo := c.InfrastructureContainer.Get().CoverageRepository.Get()
+			coverageMonitor := newMonitor(coverageRepo, sourceAlfa.Name, cfg.Drift)
+			companyService := c.CompanyService.Get()
 			htmlFetcher := c.AlfaHtmlFetcher.Get()
 			htmlParser := c.AlfaHtmlParser.Get()
-			return sourceAlfa.NewHandler(url, sitemapService, circuitManager, urlRepository,
-				vacancyRepository, htmlFetcher, htmlParser)
+			return sourceAlfa.NewHandler(cfg, sitemapService, circuitManager, urlRepository,
+				vacancyStore, snapshotStore, coverageMonitor, companyService, htmlFetcher, htmlParser)
 		},
 	}
 	c.BetaHtmlFetcher = dependency.LazyDependency[html.Fetcher]{
 		InitFunc: func() html.Fetcher {
-			var (
-				maxBodySize = int64(10 * 1024 * 1024) // 10MB
-				httpClient  *http.Client
-				err         error
-			)
-			if httpClient, err = c.ProxyService.Get().HttpClient(); err != nil {
-				log.Fatalf("get proxy http client: %v", err)
-			}
-			return htmlBeta.NewFetcher(httpClient, maxBodySize)
+			maxBodySize := int64(10 * 1024 * 1024) // 10MB
+			return htmlBeta.NewFetcher(c.HostClient.Get(), maxBodySize)
 		},
 	}
 	c.BetaHtmlParser = dependency.LazyDependency[html.Parser]{
 		InitFunc: func() html.Parser {
-			return htmlBeta.NewParser()
+			return enrich.NewParser(schema.NewParser(htmlBeta.NewParser()), c.Enrichers.Get()...)
 		},
 	}
 	c.BetaHandler = dependency.LazyDependency[*sourceBeta.Handler]{
 		InitFunc: func() *sourceBeta.Handler {
-			url := c.Config.Get().SourceHandler.Beta.SitemapURL
+			cfg := c.Config.Get().SourceHandler.Beta
 			sitemapService := c.SitemapServiceXML.Get()
 			circuitManager := c.CircuitManager.Get()
 			urlRepository := c.InfrastructureContainer.Get().UrlRepository.Get()
-			vacancyRepository := c.InfrastructureContainer.Get().VacancyRepository.Get()
+			vacancyStore := c.VacancyStore.Get()
+			snapshotStore := c.InfrastructureContainer.Get().SnapshotStore.Get()
+			coverageRepo := c.InfrastructureContainer.Get().CoverageRepository.Get()
+			coverageMonitor := newMonitor(coverageRepo, sourceBeta.Name, cfg.Drift)
+			companyService := c.CompanyService.Get()
 			htmlFetcher := c.BetaHtmlFetcher.Get()
 			htmlParser := c.BetaHtmlParser.Get()
-			return sourceBeta.NewHandler(url, sitemapService, circuitManager, urlRepository,
-				vacancyRepository, htmlFetcher, htmlParser)
+			return sourceBeta.NewHandler(cfg, sitemapService, circuitManager, urlRepository,
+				vacancyStore, snapshotStore, coverageMonitor, companyService, htmlFetcher, htmlParser)
 		},
 	}
 
@@ -201,10 +246,9 @@ func NewContainer() *Container {
 	// Sitemap services
 	c.SitemapFetcher = dependency.LazyDependency[*fetcher.Service]{
 		InitFunc: func() *fetcher.Service {
-			proxyClient := func() (*http.Client, error) {
-				return c.ProxyService.Get().HttpClient()
-			}
-			return fetcher.NewService(proxyClient)
+			return fetcher.NewService(func() (*http.Client, error) {
+				return c.HostClient.Get(), nil
+			})
 		},
 	}
 	c.SitemapNotifier = dependency.LazyDependency[*notifier.Service]{
@@ -254,7 +298,97 @@ func NewContainer() *Container {
 		InitFunc: func() *processor.Service {
 			sourceFactory := c.SourceFactory.Get()
 			batchSize := c.Config.Get().SourceHandler.BatchSize
-			return processor.NewService(sourceFactory, batchSize)
+			timeout := c.Config.Get().SourceHandler.Timeout
+			runRepository := c.InfrastructureContainer.Get().RunRepository.Get()
+			return processor.NewService(sourceFactory, batchSize, timeout, runRepository)
+		},
+	}
+	c.RequestLimiter = dependency.LazyDependency[*limiter.Limiter]{
+		InitFunc: func() *limiter.Limiter {
+			return limiter.NewLimiter(c.Config.Get().SourceHandler.MaxRequests)
+		},
+	}
+	c.HostLimiter = dependency.LazyDependency[*ratelimit.Limiter]{
+		InitFunc: func() *ratelimit.Limiter {
+			cfg := c.Config.Get().SourceHandler
+			fallback := ratelimit.Policy{RequestsPerMinute: 30, Burst: 1, MinDelay: time.Second, RespectCrawlDelay: true}
+			hostLimiter := ratelimit.NewLimiter(fallback)
+			for _, src := range []config.SourceConfig{cfg.Alfa, cfg.Beta} {
+				for _, host := range src.Hosts() {
+					hostLimiter.SetPolicy(host, newPolicy(src.RateLimit))
+				}
+			}
+			return hostLimiter
+		},
+	}
+	c.HostTransport = dependency.LazyDependency[*ratelimit.Transport]{
+		InitFunc: func() *ratelimit.Transport {
+			// The proxy client is looked up per request, so the transport keeps its robots.txt cache
+			// while the proxy client is recreated on a new circuit.
+			proxyTransport := ratelimit.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				httpClient, err := c.ProxyService.Get().HttpClient()
+				if err != nil {
+					return nil, fmt.Errorf("get proxy http client: %w", err)
+				}
+				if httpClient.Transport == nil {
+					return http.DefaultTransport.RoundTrip(req)
+				}
+				return httpClient.Transport.RoundTrip(req)
+			})
+			// Requests take a slot of the shared limiter only once their host's turn has come.
+			transport := ratelimit.NewTransport(proxyTransport, c.HostLimiter.Get())
+			transport.SetSlots(c.RequestLimiter.Get())
+			return transport
+		},
+	}
+	c.HostClient = dependency.LazyDependency[*http.Client]{
+		InitFunc: func() *http.Client {
+			httpClient, err := c.ProxyService.Get().HttpClient()
+			if err != nil {
+				log.Fatalf("get proxy http client: %v", err)
+			}
+			return c.HostTransport.Get().Wrap(httpClient)
+		},
+	}
+
+	c.CompanyService = dependency.LazyDependency[*company.Service]{
+		InitFunc: func() *company.Service {
+			companyRepository := c.InfrastructureContainer.Get().CompanyRepository.Get()
+			return company.NewService(companyRepository, c.CompanyNormalizer.Get())
+		},
+	}
+	c.CompanyNormalizer = dependency.LazyDependency[*companyNormalizer.Normalizer]{
+		InitFunc: func() *companyNormalizer.Normalizer {
+			normalizer, err := companyNormalizer.NewNormalizer()
+			if err != nil {
+				log.Fatalf("load company aliases: %v", err)
+			}
+			return normalizer
+		},
+	}
+
+	c.DedupService = dependency.LazyDependency[*dedup.Service]{
+		InitFunc: func() *dedup.Service {
+			vacancyRepository := c.InfrastructureContainer.Get().VacancyRepository.Get()
+			maxDistance, candidates := 6, 20
+			return dedup.NewService(vacancyRepository, maxDistance, candidates)
+		},
+	}
+
+	c.VacancyStore = dependency.LazyDependency[*store.Service]{
+		InitFunc: func() *store.Service {
+			vacancyRepository := c.InfrastructureContainer.Get().VacancyRepository.Get()
+			return store.NewService(vacancyRepository, c.DedupService.Get())
+		},
+	}
+
+	c.ReparseService = dependency.LazyDependency[*reparse.Service]{
+		InitFunc: func() *reparse.Service {
+			vacancyRepository := c.InfrastructureContainer.Get().VacancyRepository.Get()
+			snapshotStore := c.InfrastructureContainer.Get().SnapshotStore.Get()
+			batchSize := 100
+			return reparse.NewService(vacancyRepository, snapshotStore, c.VacancyStore.Get(), c.CompanyService.Get(),
+				batchSize)
 		},
 	}
 
@@ -273,6 +407,45 @@ func NewContainer() *Container {
 			return appScheduler.NewCronScheduler(repo, aClient, vClient, batchSize, issuer, scope, tickerTime)
 		},
 	}
+	c.CrawlerScheduler = dependency.LazyDependency[scheduler.Scheduler]{
+		InitFunc: func() scheduler.Scheduler {
+			cfg := c.Config.Get().SourceHandler
+			schedules := map[string]appScheduler.Schedule{
+				sourceAlfa.Name: newSchedule(cfg.Alfa),
+				sourceBeta.Name: newSchedule(cfg.Beta),
+			}
+			fallback := appScheduler.Schedule{Interval: time.Duration(6) * time.Hour}
+			return appScheduler.NewCrawlerScheduler(c.ProcessorService.Get(), c.SourceFactory.Get(), schedules, fallback)
+		},
+	}
 
 	return c
 }
+
+// newSchedule builds the daemon mode crawl schedule of a source from its configuration.
+func newSchedule(cfg config.SourceConfig) appScheduler.Schedule {
+	window, err := appScheduler.ParseWindow(cfg.ActiveHours)
+	if err != nil {
+		log.Fatalf("parse active hours: %v", err)
+	}
+	return appScheduler.Schedule{Interval: cfg.Interval, Jitter: cfg.Jitter, Window: window}
+}
+
+// newMonitor builds the parser drift monitor of a source from its configuration.
+func newMonitor(
+	repository coverageRepository.CoverageRepository,
+	name string,
+	cfg config.DriftConfig,
+) *coverage.Monitor {
+	return coverage.NewMonitor(repository, name, cfg.Window, cfg.MinSamples, cfg.Threshold, cfg.Pause)
+}
+
+// newPolicy builds the politeness policy of a source host from its configuration.
+func newPolicy(cfg config.RateLimitConfig) ratelimit.Policy {
+	return ratelimit.Policy{
+		RequestsPerMinute: float64(cfg.RequestsPerMinute),
+		Burst:             cfg.Burst,
+		MinDelay:          cfg.MinDelay,
+		RespectCrawlDelay: cfg.RespectCrawlDelay,
+	}
+}
@@ -23,7 +23,7 @@ func NewManager(identity *services.Identity, proxy *services.Service, url string
 }
 
 // ChangeCircuit requests a new circuit and validates it.
-func (m *Manager) ChangeCircuit() (result string, err error) {
+func (m *Manager) ChangeCircuit(ctx context.Context) (result string, err error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -32,7 +32,7 @@ func (m *Manager) ChangeCircuit() (result string, err error) {
 		response string
 	)
 
-	if err = m.identityService.Change(); err != nil {
+	if err = m.identityService.Change(ctx); err != nil {
 		return "", fmt.Errorf("identity change: %w", err)
 	}
 
@@ -41,21 +41,18 @@ func (m *Manager) ChangeCircuit() (result string, err error) {
 	}
 	defer m.proxyService.Close()
 
-	if response, err = m.validate(client); err != nil {
+	if response, err = m.validate(ctx, client); err != nil {
 		return "", fmt.Errorf("validate: %w", err)
 	}
 	return response, nil
 }
 
 // validate validates a new circuit by performing HTTP request to the validation URL.
-func (m *Manager) validate(client *http.Client) (result string, err error) {
+func (m *Manager) validate(ctx context.Context, client *http.Client) (result string, err error) {
 	check := commands.GetCheckCommand()
 	defer check.Release()
 
 	check.SetClient(client, m.url)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	if result, err = check.Execute(ctx); err != nil {
 		return "", fmt.Errorf("execute check: %w", err)
 	}
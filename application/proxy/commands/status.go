@@ -24,7 +24,7 @@ func NewStatusCommand(host, port string, factory *httpClient.Factory, timeout ti
 }
 
 // Execute checks the connectivity through the SOCKS5 proxy.
-func (c *StatusCommand) Execute(url string) (result string, err error) {
+func (c *StatusCommand) Execute(ctx context.Context, url string) (result string, err error) {
 	if c.host == "" || c.port == "" {
 		return "", errors.New("proxy host or port is not configured")
 	}
@@ -34,7 +34,7 @@ func (c *StatusCommand) Execute(url string) (result string, err error) {
 		return "", fmt.Errorf("create socks5 client: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 	return c.Ping(ctx, client, url)
 }
@@ -4,6 +4,7 @@ import (
 	"application/proxy/commands"
 	"application/proxy/commands/control"
 	"application/proxy/strategies"
+	"context"
 	"errors"
 	"fmt"
 	"infrastructure/proxy/port"
@@ -42,29 +43,30 @@ func NewIdentity(
 }
 
 // Change requests a new proxy circuit.
-func (i *Identity) Change() (err error) {
+// It gives up as soon as the context is cancelled, including while waiting between retries.
+func (i *Identity) Change(ctx context.Context) (err error) {
 	var circuitStatus string
 
 	// Current circuit status
-	if circuitStatus, err = i.statusCommand.Execute(i.url); err != nil {
+	if circuitStatus, err = i.statusCommand.Execute(ctx, i.url); err != nil {
 		return fmt.Errorf("execute status command: %w", err)
 	}
 	fmt.Printf("circuit status: %v\n", circuitStatus)
 	defer i.Close()
 
 	// Attempt to change the circuit, up to i.attempts times
-	return i.do(circuitStatus)
+	return i.do(ctx, circuitStatus)
 }
 
 // do attempts to signal a new circuit and verifies the circuit change.
-func (i *Identity) do(status string) (err error) {
+func (i *Identity) do(ctx context.Context, status string) (err error) {
 	// Authenticate
 	if err := i.authenticate(); err != nil {
 		return err
 	}
 
 	for try := 1; try <= i.attempts+1; try++ {
-		if err = i.trySignalAndCheck(status); err == nil {
+		if err = i.trySignalAndCheck(ctx, status); err == nil {
 			// Success
 			return nil
 		}
@@ -78,14 +80,18 @@ func (i *Identity) do(status string) (err error) {
 
 		// Sleep before the next attempt
 		fmt.Printf("Attempt #%d: waiting %s before retrying...\n", try+1, sleepTime)
-		time.Sleep(sleepTime)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait before retry: %w", ctx.Err())
+		case <-time.After(sleepTime):
+		}
 	}
 
 	return fmt.Errorf("maximum retry attempts exceeded")
 }
 
 // trySignalAndCheck tries to send the signal command and verifies if the circuit actually changed.
-func (i *Identity) trySignalAndCheck(oldStatus string) (err error) {
+func (i *Identity) trySignalAndCheck(ctx context.Context, oldStatus string) (err error) {
 	var newStatus string
 	// Send signal command
 	if err = i.signalCommand.Execute(); err != nil {
@@ -93,7 +99,7 @@ func (i *Identity) trySignalAndCheck(oldStatus string) (err error) {
 	}
 
 	// Get new circuit status
-	if newStatus, err = i.statusCommand.Execute(i.url); err != nil {
+	if newStatus, err = i.statusCommand.Execute(ctx, i.url); err != nil {
 		return fmt.Errorf("execute status command: %w", err)
 	}
 	fmt.Printf("new circuit status: %v\n", newStatus)
@@ -0,0 +1,18 @@
+package report
+
+import "context"
+
+// sourceKey is the context key the statistics of the current source are stored under.
+type sourceKey struct{}
+
+// WithSource returns a copy of ctx carrying the statistics of the current source.
+func WithSource(ctx context.Context, source *Source) context.Context {
+	return context.WithValue(ctx, sourceKey{}, source)
+}
+
+// FromContext returns the statistics of the current source, or nil when ctx carries none.
+// The result can be used either way, since all Source methods accept a nil receiver.
+func FromContext(ctx context.Context) *Source {
+	source, _ := ctx.Value(sourceKey{}).(*Source)
+	return source
+}
@@ -0,0 +1,191 @@
+package report
+
+import (
+	"domain/run/entity"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxErrors is the number of errors kept per source, so a failing run does not produce an unbounded report.
+const maxErrors = 50
+
+// Report collects the statistics of a single crawl run.
+// It is safe for concurrent use.
+type Report struct {
+	mu        sync.Mutex
+	startedAt time.Time          // startedAt is the time the run started.
+	sources   map[string]*Source // sources maps source names to their statistics.
+}
+
+// NewReport creates a new Report starting now.
+func NewReport() *Report {
+	return &Report{startedAt: time.Now(), sources: make(map[string]*Source)}
+}
+
+// Source returns the statistics of the named source, creating them on first use.
+func (r *Report) Source(name string) *Source {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.sources[name]; ok {
+		return s
+	}
+	s := &Source{stats: entity.SourceStats{Name: name, StartedAt: time.Now(), Failed: make(map[string]int)}}
+	r.sources[name] = s
+	return s
+}
+
+// Run returns a snapshot of the report as a run entity finishing now.
+func (r *Report) Run() *entity.Run {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run := &entity.Run{StartedAt: r.startedAt, FinishedAt: time.Now()}
+	for _, s := range r.sources {
+		run.Sources = append(run.Sources, s.Stats())
+	}
+	sort.Slice(run.Sources, func(i, j int) bool { return run.Sources[i].Name < run.Sources[j].Name })
+	return run
+}
+
+// Summary renders a run as a human-readable table.
+func Summary(run *entity.Run) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Run summary (%s, took %s)\n",
+		run.StartedAt.Format(time.RFC3339), run.FinishedAt.Sub(run.StartedAt).Round(time.Second))
+	for _, s := range run.Sources {
+		fmt.Fprintf(&b, "  %s: discovered=%d new=%d fetched=%d parsed=%d saved=%d updated=%d unchanged=%d dropped=%d "+
+			"closed=%d delisted=%d circuits=%d bytes=%d\n", s.Name, s.Discovered, s.New, s.Fetched, s.Parsed, s.Saved,
+			s.Updated, s.Unchanged, s.Dropped, s.Closed, s.Delisted, s.CircuitsRotated, s.BytesDownloaded)
+
+		reasons := make([]string, 0, len(s.Failed))
+		for reason := range s.Failed {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			fmt.Fprintf(&b, "    failed %s: %d\n", reason, s.Failed[reason])
+		}
+		for _, e := range s.Errors {
+			fmt.Fprintf(&b, "    error: %s\n", e)
+		}
+	}
+	return b.String()
+}
+
+// Source collects the statistics of a single source within a run.
+// All methods are safe for concurrent use and do nothing on a nil receiver,
+// so code paths without a report do not need to check for one.
+type Source struct {
+	mu    sync.Mutex
+	stats entity.SourceStats // stats holds the counters collected so far.
+}
+
+// AddDiscovered records URLs found in the sitemap, of which fresh were not seen before.
+func (s *Source) AddDiscovered(total, fresh int) {
+	s.update(func(stats *entity.SourceStats) {
+		stats.Discovered += total
+		stats.New += fresh
+	})
+}
+
+// AddFetched records a downloaded page of the given size.
+func (s *Source) AddFetched(bytes int) {
+	s.update(func(stats *entity.SourceStats) {
+		stats.Fetched++
+		stats.BytesDownloaded += int64(bytes)
+	})
+}
+
+// AddParsed records a page parsed into a vacancy.
+func (s *Source) AddParsed() {
+	s.update(func(stats *entity.SourceStats) { stats.Parsed++ })
+}
+
+// AddSaved records a saved vacancy.
+func (s *Source) AddSaved() {
+	s.update(func(stats *entity.SourceStats) { stats.Saved++ })
+}
+
+// AddUpdated records a re-crawled vacancy whose content changed.
+func (s *Source) AddUpdated() {
+	s.update(func(stats *entity.SourceStats) { stats.Updated++ })
+}
+
+// AddUnchanged records a re-crawled vacancy whose content did not change.
+func (s *Source) AddUnchanged() {
+	s.update(func(stats *entity.SourceStats) { stats.Unchanged++ })
+}
+
+// AddDropped records a vacancy dropped by the rules of the source.
+func (s *Source) AddDropped() {
+	s.update(func(stats *entity.SourceStats) { stats.Dropped++ })
+}
+
+// AddClosed records a posting the board closed.
+func (s *Source) AddClosed() {
+	s.update(func(stats *entity.SourceStats) { stats.Closed++ })
+}
+
+// AddDelisted records URLs delisted after they went missing from the sitemap.
+func (s *Source) AddDelisted(count int) {
+	s.update(func(stats *entity.SourceStats) { stats.Delisted += count })
+}
+
+// AddFailed records a URL that failed for the given reason.
+func (s *Source) AddFailed(reason string) {
+	s.update(func(stats *entity.SourceStats) { stats.Failed[reason]++ })
+}
+
+// AddCircuit records a proxy circuit change.
+func (s *Source) AddCircuit() {
+	s.update(func(stats *entity.SourceStats) { stats.CircuitsRotated++ })
+}
+
+// AddError records an error, keeping at most maxErrors of them.
+func (s *Source) AddError(err error) {
+	if err == nil {
+		return
+	}
+	s.update(func(stats *entity.SourceStats) {
+		if len(stats.Errors) < maxErrors {
+			stats.Errors = append(stats.Errors, err.Error())
+		}
+	})
+}
+
+// Finish marks the source as finished now.
+func (s *Source) Finish() {
+	s.update(func(stats *entity.SourceStats) { stats.FinishedAt = time.Now() })
+}
+
+// Stats returns a copy of the statistics collected so far.
+func (s *Source) Stats() entity.SourceStats {
+	if s == nil {
+		return entity.SourceStats{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.Failed = make(map[string]int, len(s.stats.Failed))
+	for reason, count := range s.stats.Failed {
+		stats.Failed[reason] = count
+	}
+	stats.Errors = append([]string(nil), s.stats.Errors...)
+	return stats
+}
+
+// update applies fn to the statistics under the lock.
+func (s *Source) update(fn func(stats *entity.SourceStats)) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.stats)
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"application/source"
+	"application/url/processor"
+	"context"
+	domainSource "domain/source"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CrawlerScheduler is a long-running scheduler that crawls every registered source on its own schedule.
+type CrawlerScheduler struct {
+	processor *processor.Service  // Processor runs a single source in isolation.
+	factory   *source.Factory     // Factory provides the registered source handlers.
+	schedules map[string]Schedule // Schedules of the sources keyed by source name.
+	fallback  Schedule            // Schedule used for sources without an explicit schedule.
+	done      chan struct{}       // Signal channel used to stop the scheduler's loops.
+	wg        sync.WaitGroup      // Tracks the running source loops.
+}
+
+// NewCrawlerScheduler creates a new instance of CrawlerScheduler.
+func NewCrawlerScheduler(
+	processor *processor.Service,
+	factory *source.Factory,
+	schedules map[string]Schedule,
+	fallback Schedule,
+) *CrawlerScheduler {
+	return &CrawlerScheduler{
+		processor: processor,
+		factory:   factory,
+		schedules: schedules,
+		fallback:  fallback,
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches one crawl loop per registered source.
+// The loops exit when the context is cancelled or Stop is called.
+func (s *CrawlerScheduler) Start(ctx context.Context) {
+	fmt.Println("starting crawler scheduler...")
+
+	for name, handler := range s.factory.GetAllHandlers() {
+		schedule, ok := s.schedules[name]
+		if !ok {
+			schedule = s.fallback
+		}
+
+		s.wg.Add(1)
+		go func(name string, handler domainSource.Handler, schedule Schedule) {
+			defer s.wg.Done()
+			s.loop(ctx, name, handler, schedule)
+		}(name, handler, schedule)
+	}
+}
+
+// Stop terminates the scheduler and waits for the running crawls to return.
+func (s *CrawlerScheduler) Stop() {
+	close(s.done)
+	s.wg.Wait()
+	fmt.Println("crawler scheduler exit")
+}
+
+// loop crawls a single source whenever its schedule allows it.
+func (s *CrawlerScheduler) loop(ctx context.Context, name string, handler domainSource.Handler, schedule Schedule) {
+	for {
+		// Outside the active hours we only wait for the window to open.
+		delay := schedule.Window.untilOpen(time.Now())
+		if delay == 0 {
+			started := time.Now()
+			if err := s.processor.RunSource(ctx, name, handler); err != nil {
+				fmt.Printf("[WARN] crawl of source %s failed: %v\n", name, err)
+			}
+			delay = schedule.Delay(started, time.Now())
+		}
+		fmt.Printf("[INFO] next crawl of source %s in %s\n", name, delay.Round(time.Second))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-s.done:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
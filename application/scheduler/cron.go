@@ -11,10 +11,12 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-// CronScheduler is a periodic job scheduler that transfers vacancies over gRPC.
+// CronScheduler is a periodic job scheduler that transfers vacancies over gRPC and retracts the closed ones.
 type CronScheduler struct {
 	repository     repository.VacancyRepository // Repository is used to fetch and update vacancy entities.
 	authClient     *authClient.AuthClient       // Manages gRPC client connection to AuthService.
@@ -66,6 +68,9 @@ func (s *CronScheduler) Start(ctx context.Context) {
 				if err := s.transferVacancies(ctx); err != nil {
 					fmt.Printf("transfer vacancies err: %v\n", err)
 				}
+				if err := s.retractVacancies(ctx); err != nil {
+					fmt.Printf("retract vacancies err: %v\n", err)
+				}
 			}
 		}
 	}()
@@ -105,6 +110,64 @@ func (s *CronScheduler) transferVacancies(ctx context.Context) (err error) {
 	}
 }
 
+// retractVacancies deletes the vacancies the boards closed from the remote service, batch by batch.
+// It stops when a batch could not be retracted at all, the remaining vacancies are retried on the next tick.
+func (s *CronScheduler) retractVacancies(ctx context.Context) (err error) {
+	var (
+		jwtToken string
+		items    []*entity.Vacancy
+	)
+
+	if jwtToken, err = s.generateToken(ctx); err != nil {
+		return fmt.Errorf("generate token: %w", err)
+	}
+	outCtx := metadata.AppendToOutgoingContext(ctx, "authorization", fmt.Sprintf("Bearer %s", jwtToken))
+
+	for {
+		if items, err = s.repository.FetchClosed(ctx, s.batchSize); err != nil {
+			return fmt.Errorf("fetch closed: %w", err)
+		}
+
+		retracted := 0
+		for _, item := range items {
+			if rErr := s.retractVacancy(outCtx, item); rErr != nil {
+				fmt.Printf("[WARN] could not retract vacancy (ID=%s): %v\n", item.ID.Hex(), rErr)
+				continue
+			}
+			retracted++
+			fmt.Printf("[INFO] retracted closed vacancy (ID=%s)\n", item.ID.Hex())
+		}
+		if len(items) < s.batchSize || retracted == 0 {
+			return nil
+		}
+	}
+}
+
+// retractVacancy deletes the closed vacancy from the remote service and forgets its remote ID.
+// The open duplicates skipped in its favour are released, so that one of them is published in its place.
+func (s *CronScheduler) retractVacancy(ctx context.Context, item *entity.Vacancy) (err error) {
+	if _, err = s.vacancyClient.DeleteVacancy(ctx, item.RemoteID); err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("delete vacancy over gRPC: %w", err)
+	}
+
+	item.RemoteID = 0
+	if err = s.repository.Update(ctx, item); err != nil {
+		return fmt.Errorf("update RemoteID field: %w", err)
+	}
+
+	if item.ClusterID == "" {
+		return nil
+	}
+	released, err := s.repository.ReleaseCluster(ctx, item.ClusterID)
+	if err != nil {
+		return fmt.Errorf("release cluster %s: %w", item.ClusterID, err)
+	}
+	if released > 0 {
+		fmt.Printf("[INFO] released %d duplicates of retracted vacancy (ID=%s)\n", released, item.ID.Hex())
+	}
+	return nil
+}
+
 // processBatch retrieves and processes a batch of vacancies.
 func (s *CronScheduler) processBatch(ctx context.Context, batchSize, maxConcurrency int) (hasMore bool, err error) {
 	var (
@@ -120,6 +183,9 @@ func (s *CronScheduler) processBatch(ctx context.Context, batchSize, maxConcurre
 		fmt.Println("no more items to process")
 		return false, nil
 	}
+	if items, err = s.skipDuplicates(ctx, items); err != nil {
+		return false, fmt.Errorf("skip duplicates: %w", err)
+	}
 
 	// Pre-fill a channel with concurrency slot IDs (1...maxConcurrency).
 	slots := make(chan int, maxConcurrency)
@@ -159,10 +225,67 @@ func (s *CronScheduler) processBatch(ctx context.Context, batchSize, maxConcurre
 	return true, nil
 }
 
+// skipDuplicates returns the items to send, so that each cluster of near-duplicates is sent once.
+// Items whose cluster was already sent are marked as sent without being sent again. Of the items sharing a
+// cluster within the batch only the first is kept, the others are left for a later batch, by which time their
+// cluster is sent. Changed vacancies that were sent before are always kept, so the remote copy is updated.
+func (s *CronScheduler) skipDuplicates(ctx context.Context, items []*entity.Vacancy) ([]*entity.Vacancy, error) {
+	var (
+		kept     = make([]*entity.Vacancy, 0, len(items))
+		clusters = make(map[string]struct{}, len(items))
+	)
+
+	for _, item := range items {
+		if item.ClusterID == "" || item.RemoteID != 0 {
+			kept = append(kept, item)
+			continue
+		}
+		if _, ok := clusters[item.ClusterID]; ok {
+			continue
+		}
+		clusters[item.ClusterID] = struct{}{}
+
+		sent, err := s.repository.ClusterSent(ctx, item.ClusterID)
+		if err != nil {
+			return nil, fmt.Errorf("check cluster %s: %w", item.ClusterID, err)
+		}
+		if !sent {
+			kept = append(kept, item)
+			continue
+		}
+
+		item.SentAt = time.Now()
+		if err = s.repository.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("update SentAt field of duplicate: %w", err)
+		}
+		fmt.Printf("[INFO] skipped vacancy (ID=%s), cluster %s was already sent\n", item.ID.Hex(), item.ClusterID)
+	}
+	return kept, nil
+}
+
 // sendVacancy calls the vacancyClient to create a vacancy via gRPC and updates the local entity's SentAt field.
+// Invalid vacancies are quarantined instead of being sent. A vacancy that changed after it was sent replaces
+// its remote copy, as the remote service cannot update vacancies in place.
 func (s *CronScheduler) sendVacancy(ctx context.Context, item *entity.Vacancy) (err error) {
+	if vErr := item.Validate(); vErr != nil {
+		item.Quarantine(vErr.Error())
+		if err = s.repository.Update(ctx, item); err != nil {
+			return fmt.Errorf("quarantine vacancy: %w", err)
+		}
+		return fmt.Errorf("vacancy quarantined: %w", vErr)
+	}
+
+	change := "new"
+	if item.RemoteID != 0 {
+		change = "changed"
+		// A remote copy that is already gone does not stop sending the new one.
+		if _, err = s.vacancyClient.DeleteVacancy(ctx, item.RemoteID); err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("delete changed vacancy over gRPC: %w", err)
+		}
+	}
+
 	// Create the vacancy on the remote service via gRPC.
-	_, err = s.vacancyClient.CreateVacancy(
+	resp, err := s.vacancyClient.CreateVacancy(
 		ctx,
 		item.Title,
 		item.Company,
@@ -173,10 +296,11 @@ func (s *CronScheduler) sendVacancy(ctx context.Context, item *entity.Vacancy) (
 		return fmt.Errorf("send vacancy over gRPC: %w", err)
 	}
 
-	item.SentAt = time.Now()
+	item.SentAt, item.RemoteID = time.Now(), resp.GetId()
 	if err = s.repository.Update(ctx, item); err != nil {
 		return fmt.Errorf("update SentAt field: %w", err)
 	}
+	fmt.Printf("[INFO] sent %s vacancy (ID=%s, remote ID=%d)\n", change, item.ID.Hex(), item.RemoteID)
 	return nil
 }
 
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Window is a daily time window expressed in whole hours.
+// A window whose start equals its end (including the zero value) is always open.
+// A window whose start is after its end spans midnight (e.g., 22-06).
+type Window struct {
+	From int // From is the hour (0-23) the window opens.
+	To   int // To is the hour (0-24) the window closes.
+}
+
+// ParseWindow parses a window in the "HH-HH" format. An empty string yields an always open window.
+func ParseWindow(value string) (window Window, err error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return Window{}, nil
+	}
+
+	from, to, found := strings.Cut(value, "-")
+	if !found {
+		return Window{}, fmt.Errorf("invalid window %q: expected HH-HH", value)
+	}
+	if window.From, err = strconv.Atoi(strings.TrimSpace(from)); err != nil || window.From < 0 || window.From > 23 {
+		return Window{}, fmt.Errorf("invalid window %q: start hour must be between 0 and 23", value)
+	}
+	if window.To, err = strconv.Atoi(strings.TrimSpace(to)); err != nil || window.To < 0 || window.To > 24 {
+		return Window{}, fmt.Errorf("invalid window %q: end hour must be between 0 and 24", value)
+	}
+	return window, nil
+}
+
+// Contains reports whether the given time falls within the window.
+func (w Window) Contains(t time.Time) bool {
+	if w.From == w.To {
+		return true
+	}
+	h := t.Hour()
+	if w.From < w.To {
+		return h >= w.From && h < w.To
+	}
+	return h >= w.From || h < w.To
+}
+
+// untilOpen returns how long it takes from t until the window opens, or zero if it is already open.
+func (w Window) untilOpen(t time.Time) time.Duration {
+	if w.Contains(t) {
+		return 0
+	}
+	open := time.Date(t.Year(), t.Month(), t.Day(), w.From, 0, 0, 0, t.Location())
+	if !open.After(t) {
+		open = open.AddDate(0, 0, 1)
+	}
+	return open.Sub(t)
+}
+
+// Schedule describes how often and when a source is crawled in daemon mode.
+type Schedule struct {
+	Interval time.Duration // Interval is the time between the start of two consecutive runs.
+	Jitter   time.Duration // Jitter is the maximum random delay added to each interval.
+	Window   Window        // Window restricts runs to a daily window of hours.
+}
+
+// Delay calculates how long to wait after now before the next run may start, given the start of the previous run.
+// The next run starts one interval, extended by a random jitter, after the previous one started, or right away if
+// the previous run took longer than that. It is then postponed, if needed, until the window opens.
+func (s Schedule) Delay(started, now time.Time) time.Duration {
+	delay := max(started.Add(s.Interval+s.jitter()).Sub(now), 0)
+	return delay + s.Window.untilOpen(now.Add(delay))
+}
+
+// jitter returns a random duration in the range [0, Jitter).
+func (s Schedule) jitter() time.Duration {
+	if s.Jitter <= 0 {
+		return 0
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(s.Jitter)))
+	if err != nil {
+		return 0
+	}
+	return time.Duration(n.Int64())
+}
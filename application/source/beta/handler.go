@@ -1,71 +1,141 @@
 package beta
 
 import (
+	"application/company"
+	"application/config"
+	"application/coverage"
 	"application/proxy/circuit"
+	"application/report"
+	"application/source"
 	"application/url/processor/dto"
 	"application/url/sitemap"
+	"application/vacancy/store"
 	"context"
+	companyEntity "domain/company/entity"
 	"domain/html"
+	"domain/pipeline"
+	snapshotEntity "domain/snapshot/entity"
+	snapshotRepository "domain/snapshot/repository"
 	"domain/url/entity"
 	urlRepository "domain/url/repository"
 	vacancyEntity "domain/vacancy/entity"
-	vacancyRepository "domain/vacancy/repository"
 	"fmt"
 	"sync"
 	"time"
 )
 
+// Name is the name the source is registered and stored under.
+const Name = "beta"
+
 // Handler processes URLs and HTML content.
 type Handler struct {
-	url               string                              // Base URL of the sitemap to process.
-	sitemapService    *sitemap.Service                    // Service processes the URLs from a sitemap.
-	circuitManager    *circuit.Manager                    // Service manages the proxy circuit lifecycle.
-	urlRepository     urlRepository.UrlRepository         // Service manages URL entities in the data source.
-	vacancyRepository vacancyRepository.VacancyRepository // Service handles the storage of parsed vacancy details.
-	fetcher           html.Fetcher                        // Service fetches HTML content over HTTP.
-	parser            html.Parser                         // Service extracts vacancy details from raw HTML content.
+	url             string                                // Base URL of the sitemap to process.
+	concurrency     int                                   // Number of URLs processed in parallel.
+	drainTimeout    time.Duration                         // Time in-flight URLs may keep running after cancellation.
+	batchDelay      time.Duration                         // Pause between two batches of URLs.
+	delistAfter     int                                   // Number of runs missing a URL before it is delisted.
+	recrawlAfter    time.Duration                         // Age at which processed URLs are fetched again.
+	languageRule    *source.LanguageRule                  // Rule dropping vacancies in unwanted languages.
+	sitemapService  *sitemap.Service                      // Service processes the URLs from a sitemap.
+	circuitManager  *circuit.Manager                      // Service manages the proxy circuit lifecycle.
+	urlRepository   urlRepository.UrlRepository           // Service manages URL entities in the data source.
+	vacancyStore    *store.Service                        // Service handles the storage of parsed vacancy details.
+	snapshotStore   snapshotRepository.SnapshotRepository // Service stores the raw responses of fetched pages.
+	coverageMonitor *coverage.Monitor                     // Service detects parser drift from the field coverage.
+	companyService  *company.Service                      // Service links vacancies to the companies offering them.
+	fetcher         html.Fetcher                          // Service fetches HTML content over HTTP.
+	parser          html.Parser                           // Service extracts vacancy details from raw HTML content.
 }
 
 // NewHandler creates and returns a new Handler instance.
 func NewHandler(
-	url string,
+	cfg config.SourceConfig,
 	sitemapService *sitemap.Service,
 	circuitManager *circuit.Manager,
 	urlRepo urlRepository.UrlRepository,
-	vacancyRepo vacancyRepository.VacancyRepository,
+	vacancyStore *store.Service,
+	snapshotStore snapshotRepository.SnapshotRepository,
+	coverageMonitor *coverage.Monitor,
+	companyService *company.Service,
 	fetcher html.Fetcher,
 	parser html.Parser,
 ) *Handler {
 	return &Handler{
-		url:               url,
-		sitemapService:    sitemapService,
-		circuitManager:    circuitManager,
-		urlRepository:     urlRepo,
-		vacancyRepository: vacancyRepo,
-		fetcher:           fetcher,
-		parser:            parser,
+		url:             cfg.SitemapURL,
+		concurrency:     max(cfg.Concurrency, 1),
+		drainTimeout:    cfg.DrainTimeout,
+		batchDelay:      cfg.BatchDelay,
+		delistAfter:     cfg.DelistAfter,
+		recrawlAfter:    cfg.RecrawlAfter,
+		languageRule:    source.NewLanguageRule(cfg.Language),
+		sitemapService:  sitemapService,
+		circuitManager:  circuitManager,
+		urlRepository:   urlRepo,
+		vacancyStore:    vacancyStore,
+		snapshotStore:   snapshotStore,
+		coverageMonitor: coverageMonitor,
+		companyService:  companyService,
+		fetcher:         fetcher,
+		parser:          parser,
 	}
 }
 
 // ProcessURLs retrieves and processes sitemap URLs.
+// The vacancies of URLs delisted from the sitemap are closed, closing them is best effort.
 func (h *Handler) ProcessURLs(ctx context.Context) (err error) {
-	if err = h.sitemapService.ProcessUrls(ctx, h.url); err != nil {
+	var delisted []*entity.Url
+
+	if delisted, err = h.sitemapService.ProcessUrls(ctx, Name, h.url, h.delistAfter); err != nil {
 		return fmt.Errorf("process urls: %w", err)
 	}
+	for _, url := range delisted {
+		if _, cErr := h.vacancyStore.Close(ctx, Name, url.Address, time.Now()); cErr != nil {
+			fmt.Printf("[WARN] failed to close vacancy of delisted URL %s: %v\n", url.Address, cErr)
+			report.FromContext(ctx).AddError(fmt.Errorf("close delisted vacancy, %s: %w", url.Address, cErr))
+		}
+	}
+	if len(delisted) > 0 {
+		fmt.Printf("[INFO] delisted %d URLs missing from the sitemap of source %s\n", len(delisted), Name)
+	}
+
+	// Requeue URLs processed long enough ago, so that changed and closed vacancies are picked up.
+	if h.recrawlAfter > 0 {
+		requeued, rErr := h.urlRepository.Requeue(ctx, Name, time.Now().Add(-h.recrawlAfter))
+		if rErr != nil {
+			return fmt.Errorf("requeue processed urls: %w", rErr)
+		}
+		if requeued > 0 {
+			fmt.Printf("[INFO] requeued %d URLs of source %s for re-crawling\n", requeued, Name)
+		}
+	}
 	return nil
 }
 
 // ProcessHTML processes URLs in batches with a delay.
+// It stops claiming new batches as soon as the context is cancelled, and does nothing while the source
+// is paused after parser drift.
 func (h *Handler) ProcessHTML(ctx context.Context, batchSize int) (err error) {
 	var (
-		maxConcurrency = 5
-		hasMore        bool
-		delayTime      = time.Duration(15) * time.Second
+		hasMore     bool
+		pausedUntil time.Time
 	)
 
+	if pausedUntil, err = h.coverageMonitor.PausedUntil(ctx); err != nil {
+		return fmt.Errorf("check drift pause: %w", err)
+	}
+	if !pausedUntil.IsZero() {
+		fmt.Printf("[WARN] HTML processing of source %s is paused until %s after parser drift\n",
+			Name, pausedUntil.Format(time.RFC3339))
+		return nil
+	}
+
 	for {
+		if err = ctx.Err(); err != nil {
+			return fmt.Errorf("stop processing: %w", err)
+		}
+
 		// Attempt to process a batch of URLs
-		if hasMore, err = h.processBatch(ctx, batchSize, maxConcurrency); err != nil {
+		if hasMore, err = h.processBatch(ctx, batchSize, h.concurrency); err != nil {
 			return fmt.Errorf("process batch: %w", err)
 		}
 		if !hasMore {
@@ -74,11 +144,17 @@ func (h *Handler) ProcessHTML(ctx context.Context, batchSize int) (err error) {
 
 		// Delay before the next iteration
 		fmt.Println("Sleeping...")
-		time.Sleep(delayTime)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for next batch: %w", ctx.Err())
+		case <-time.After(h.batchDelay):
+		}
 	}
 }
 
 // processBatch fetches a batch of URLs and processes them respecting the maxConcurrency limit.
+// Once the context is cancelled no further URLs are started, while the ones already running
+// are given up to the drain timeout to complete.
 func (h *Handler) processBatch(ctx context.Context, batchSize, maxConcurrency int) (hasMore bool, err error) {
 	var (
 		urls         []*entity.Url
@@ -87,12 +163,13 @@ func (h *Handler) processBatch(ctx context.Context, batchSize, maxConcurrency in
 	)
 
 	// Change identity
-	if switchResult, err = h.circuitManager.ChangeCircuit(); err != nil {
+	if switchResult, err = h.circuitManager.ChangeCircuit(ctx); err != nil {
 		return false, fmt.Errorf("change circuit: %w", err)
 	}
 	fmt.Printf("Switch Result: %s\n", switchResult)
+	report.FromContext(ctx).AddCircuit()
 
-	if urls, err = h.urlRepository.FetchBatch(ctx, status, batchSize); err != nil {
+	if urls, err = h.urlRepository.FetchBatch(ctx, Name, status, batchSize); err != nil {
 		return false, fmt.Errorf("fetch batch: %w", err)
 	}
 	if len(urls) == 0 {
@@ -100,62 +177,151 @@ func (h *Handler) processBatch(ctx context.Context, batchSize, maxConcurrency in
 	}
 
 	var (
-		semaphore = make(chan struct{}, maxConcurrency)
-		wg        sync.WaitGroup
+		semaphore       = make(chan struct{}, maxConcurrency)
+		wg              sync.WaitGroup
+		batch           = coverage.NewBatch()
+		workCtx, cancel = source.Detach(ctx, h.drainTimeout)
 	)
+	defer cancel()
 
-	wg.Add(len(urls))
 	for _, url := range urls {
-		semaphore <- struct{}{}
+		select {
+		case <-ctx.Done():
+			// Stop claiming URLs, the remaining ones stay pending for the next run.
+			wg.Wait()
+			return false, fmt.Errorf("drain batch: %w", ctx.Err())
+		case semaphore <- struct{}{}:
+		}
 
+		wg.Add(1)
 		go func(entity *entity.Url) {
 			defer wg.Done()
 			defer func() { <-semaphore }()
 			defer func() {
 				if r := recover(); r != nil {
 					fmt.Printf("[ERROR] Recovered from panic in processUrl for %s: %v\n", entity.Address, r)
+					report.FromContext(ctx).AddError(fmt.Errorf("panic processing %s: %v", entity.Address, r))
 				}
 			}()
 
 			// Workload
-			if pErr := h.processUrl(ctx, entity); pErr != nil {
+			if pErr := h.processUrl(workCtx, entity, batch); pErr != nil {
 				fmt.Printf("[WARN] failed to process URL %s: %v\n", entity.Address, pErr)
+				report.FromContext(ctx).AddError(pErr)
 				return
 			}
 		}(url)
 	}
 	wg.Wait()
 
+	// Compare the field coverage of the batch against the baseline.
+	if err = h.checkCoverage(ctx, batch); err != nil {
+		return false, err
+	}
+
 	// There might be more items in subsequent batches
 	return true, nil
 }
 
+// checkCoverage stores the field coverage of the batch and returns an error if the parser drifted.
+// Failing to store the coverage is reported but does not stop processing.
+func (h *Handler) checkCoverage(ctx context.Context, batch *coverage.Batch) error {
+	stats, err := h.coverageMonitor.Check(ctx, h.parser, batch)
+	if err != nil {
+		fmt.Printf("[WARN] failed to check field coverage of source %s: %v\n", Name, err)
+		report.FromContext(ctx).AddError(fmt.Errorf("check coverage: %w", err))
+		return nil
+	}
+	if stats != nil && stats.Drifted() {
+		return fmt.Errorf("%w on fields %v, paused until %s",
+			coverage.ErrDrift, stats.Drift, stats.PausedUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
 // processUrl fetches, parses, and saves data for a single URL.
-func (h *Handler) processUrl(ctx context.Context, url *entity.Url) (err error) {
+// The fields extracted from the page are recorded in batch.
+func (h *Handler) processUrl(ctx context.Context, url *entity.Url, batch *coverage.Batch) (err error) {
 	var (
 		processedTime = time.Now()
-		body          string
+		response      *html.Response
 		result        *dto.Vacancy
 		vacancy       = &vacancyEntity.Vacancy{}
+		stats         = report.FromContext(ctx)
 	)
 
 	// Fetch raw HTML content from the URL.
-	if body, err = h.fetcher.Fetch(ctx, url.Address); err != nil {
-		if err = h.updateStatus(ctx, url, "failed", processedTime); err != nil {
-			return fmt.Errorf("%w", err)
+	response, err = h.fetcher.Fetch(ctx, url.Address)
+	snapshotID := h.saveSnapshot(ctx, url, response)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Interrupted by shutdown, leave the URL pending so the next run picks it up again.
+			return fmt.Errorf("fetch interrupted, %s: %w", url.Address, err)
 		}
+		if reason, _ := pipeline.Classify(err, ""); reason == pipeline.ReasonClosed {
+			return h.markClosed(ctx, url, processedTime, err)
+		}
+		return h.markFailed(ctx, url, processedTime, fmt.Errorf("fetch url, %s: %w", url.Address, err))
 	}
+	stats.AddFetched(len(response.Body))
 
 	// Parse the fetched HTML into structured format.
-	if result, err = h.parser.Parse(body); err != nil {
-		return fmt.Errorf("parse url, %s: %w", url.Address, err)
+	if result, err = h.parser.ParseAt(response.Body, response.FetchedAt); err != nil {
+		return h.markFailed(ctx, url, processedTime, pipeline.Parse(fmt.Errorf("parse url, %s: %w", url.Address, err)))
 	}
 	defer result.Release()
+	stats.AddParsed()
+	batch.Add(result)
+
+	// Record where the vacancy comes from, pages that do not announce a job ID get one from their address.
+	result.SourceName, result.SourceURL = Name, url.Address
+	if result.ExternalID == "" {
+		result.ExternalID = source.ExternalID(url.Address)
+	}
+
+	// Drop vacancies of blocked companies, and those written in a language the source is not wanted for
+	// unless their company is allowed.
+	employer := h.resolveCompany(ctx, url, result.Company, processedTime)
+	if reason := h.dropReason(employer, result); reason != "" {
+		stats.AddDropped()
+		if err = h.updateStatus(ctx, url, "dropped", processedTime); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		fmt.Printf("[INFO] dropped URL %s, %s\n", url.Address, reason)
+		return nil
+	}
 
-	// Save
+	// Validate, invalid vacancies are kept in quarantine instead of being stored as valid.
 	result.ToEntity(vacancy)
-	if err = h.vacancyRepository.Save(ctx, vacancy); err != nil {
-		return fmt.Errorf("save vacancy, %s: %w", vacancy, err)
+	vacancy.SnapshotID = snapshotID
+	if employer != nil {
+		vacancy.CompanyID = employer.ID.Hex()
+	}
+	vacancy.Parser, vacancy.ParserVersion = h.parser.Name(), h.parser.Version()
+	vacancy.SetPostedAt(url.PublishedAt, vacancyEntity.DateConfidenceHigh)
+	if vErr := vacancy.Validate(); vErr != nil {
+		vacancy.Quarantine(vErr.Error())
+		if _, err = h.vacancyStore.Save(ctx, vacancy); err != nil {
+			err = pipeline.Storage(fmt.Errorf("quarantine vacancy, %s: %w", url.Address, err))
+			return h.markFailed(ctx, url, processedTime, err)
+		}
+		err = pipeline.Validation(fmt.Errorf("validate vacancy, %s: %w", url.Address, vErr))
+		return h.markFailed(ctx, url, processedTime, err)
+	}
+
+	// Save, re-crawled vacancies are updated in place only when their content changed.
+	outcome, err := h.vacancyStore.Save(ctx, vacancy)
+	if err != nil {
+		return h.markFailed(ctx, url, processedTime, pipeline.Storage(fmt.Errorf("save vacancy, %s: %w", url.Address, err)))
+	}
+	switch outcome {
+	case store.OutcomeCreated:
+		stats.AddSaved()
+		h.countVacancy(ctx, url, employer)
+	case store.OutcomeUpdated:
+		stats.AddUpdated()
+	case store.OutcomeUnchanged:
+		stats.AddUnchanged()
 	}
 
 	// Update URL status
@@ -174,3 +340,97 @@ func (h *Handler) updateStatus(ctx context.Context, entity *entity.Url, status s
 	}
 	return nil
 }
+
+// markFailed records the classified failure of the URL and returns the original error.
+// Errors that were not classified by the pipeline are treated as network failures.
+func (h *Handler) markFailed(ctx context.Context, entity *entity.Url, time time.Time, cause error) error {
+	reason, httpStatus := pipeline.Classify(cause, pipeline.ReasonNetwork)
+	report.FromContext(ctx).AddFailed(string(reason))
+
+	if err := h.urlRepository.MarkFailed(ctx, entity.ID.Hex(), string(reason), httpStatus, &time); err != nil {
+		return fmt.Errorf("mark failed: %w (after %w)", err, cause)
+	}
+	return cause
+}
+
+// markClosed records that the board closed the posting of the URL and closes its stored vacancy, if any,
+// so that it is retracted from the remote service.
+func (h *Handler) markClosed(ctx context.Context, entity *entity.Url, time time.Time, cause error) (err error) {
+	closed, err := h.vacancyStore.Close(ctx, Name, entity.Address, time)
+	if err != nil {
+		return pipeline.Storage(fmt.Errorf("close vacancy, %s: %w", entity.Address, err))
+	}
+	report.FromContext(ctx).AddClosed()
+
+	if err = h.updateStatus(ctx, entity, "closed", time); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	fmt.Printf("[INFO] closed URL %s (vacancy stored: %t): %v\n", entity.Address, closed, cause)
+	return nil
+}
+
+// saveSnapshot stores the raw response of the URL and links it to the URL entity.
+// Storing snapshots is best effort, failures are reported but do not stop processing.
+// Returns the snapshot ID, or an empty string if nothing was stored.
+func (h *Handler) saveSnapshot(ctx context.Context, entity *entity.Url, response *html.Response) string {
+	if response == nil {
+		return ""
+	}
+
+	snapshot := &snapshotEntity.Snapshot{
+		URL:        entity.Address,
+		FinalURL:   response.FinalURL,
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		FetchedAt:  response.FetchedAt,
+		Body:       response.Body,
+	}
+	if err := h.snapshotStore.Save(ctx, snapshot); err != nil {
+		fmt.Printf("[WARN] failed to store snapshot of %s: %v\n", entity.Address, err)
+		report.FromContext(ctx).AddError(fmt.Errorf("store snapshot, %s: %w", entity.Address, err))
+		return ""
+	}
+	if err := h.urlRepository.SetSnapshot(ctx, entity.ID.Hex(), snapshot.ID); err != nil {
+		fmt.Printf("[WARN] failed to link snapshot of %s: %v\n", entity.Address, err)
+	}
+	return snapshot.ID
+}
+
+// resolveCompany returns the company offering the vacancy, or nil if it is unknown.
+// Resolving companies is best effort, failures are reported but do not stop processing.
+func (h *Handler) resolveCompany(
+	ctx context.Context,
+	entity *entity.Url,
+	name string,
+	seenAt time.Time,
+) *companyEntity.Company {
+	employer, err := h.companyService.Resolve(ctx, name, seenAt)
+	if err != nil {
+		fmt.Printf("[WARN] failed to resolve company of %s: %v\n", entity.Address, err)
+		report.FromContext(ctx).AddError(fmt.Errorf("resolve company, %s: %w", entity.Address, err))
+		return nil
+	}
+	return employer
+}
+
+// countVacancy adds the newly stored vacancy to the count of its company, which may be nil.
+// Counting is best effort, failures are reported but do not stop processing.
+func (h *Handler) countVacancy(ctx context.Context, entity *entity.Url, employer *companyEntity.Company) {
+	if err := h.companyService.CountVacancy(ctx, employer); err != nil {
+		fmt.Printf("[WARN] failed to count vacancy of %s: %v\n", entity.Address, err)
+		report.FromContext(ctx).AddError(fmt.Errorf("count vacancy, %s: %w", entity.Address, err))
+	}
+}
+
+// dropReason returns why the vacancy is dropped, or an empty string if it is kept.
+func (h *Handler) dropReason(employer *companyEntity.Company, vacancy *dto.Vacancy) string {
+	switch {
+	case employer.Blocked():
+		return fmt.Sprintf("company %s is blocked", employer.Key)
+	case employer.Allowed():
+		return ""
+	case !h.languageRule.Keep(vacancy.Language, vacancy.LanguageConfidence):
+		return fmt.Sprintf("vacancy is in unwanted language %s", vacancy.Language)
+	}
+	return ""
+}
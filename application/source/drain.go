@@ -0,0 +1,22 @@
+package source
+
+import (
+	"context"
+	"time"
+)
+
+// Detach returns a context for in-flight work that outlives the cancellation of parent by at most grace.
+// Values of parent are preserved. Once parent is done, the returned context is cancelled after grace,
+// which lets started work finish cleanly while still bounding how long shutdown may take.
+func Detach(parent context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parent))
+
+	stop := context.AfterFunc(parent, func() {
+		time.AfterFunc(grace, cancel)
+	})
+
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
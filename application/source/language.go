@@ -0,0 +1,39 @@
+package source
+
+import (
+	"application/config"
+	"strings"
+)
+
+// LanguageRule decides which vacancies of a source are kept by the language they are written in.
+type LanguageRule struct {
+	allow         map[string]bool // The languages kept, empty means all languages.
+	deny          map[string]bool // The languages dropped.
+	minConfidence float64         // The detection confidence required to drop a vacancy.
+}
+
+// NewLanguageRule creates and returns a new LanguageRule instance.
+func NewLanguageRule(cfg config.LanguageConfig) *LanguageRule {
+	return &LanguageRule{allow: codes(cfg.Allow), deny: codes(cfg.Deny), minConfidence: cfg.MinConfidence}
+}
+
+// Keep reports whether a vacancy in the given language, detected with the given confidence, is kept.
+// Vacancies whose language is unknown or uncertain are kept, so that detection errors do not lose postings.
+func (r *LanguageRule) Keep(language string, confidence float64) bool {
+	if language == "" || confidence < r.minConfidence {
+		return true
+	}
+	if r.deny[language] {
+		return false
+	}
+	return len(r.allow) == 0 || r.allow[language]
+}
+
+// codes returns the set of the lower-cased language codes.
+func codes(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, code := range list {
+		set[strings.ToLower(strings.TrimSpace(code))] = true
+	}
+	return set
+}
@@ -0,0 +1,42 @@
+package source
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// idParams are the query parameters boards commonly carry the job ID in.
+var idParams = []string{"id", "jobid", "job_id", "offerid", "offer_id"}
+
+// digits matches the trailing run of digits of a path segment, as in "senior-go-developer-12345".
+var digits = regexp.MustCompile(`(\d{4,})(?:\.\w+)?$`)
+
+// ExternalID derives the board-specific job ID from the address of a vacancy page, for pages that do not
+// announce one. It prefers an ID query parameter, then a trailing number of the last path segment, and falls
+// back to the last path segment itself. Returns an empty string if the address has none of them.
+func ExternalID(address string) string {
+	u, err := url.Parse(strings.TrimSpace(address))
+	if err != nil {
+		return ""
+	}
+
+	query := u.Query()
+	for key := range query {
+		for _, param := range idParams {
+			if strings.EqualFold(key, param) && query.Get(key) != "" {
+				return query.Get(key)
+			}
+		}
+	}
+
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	if len(segments) == 0 {
+		return ""
+	}
+	last := segments[len(segments)-1]
+	if match := digits.FindStringSubmatch(last); match != nil {
+		return match[1]
+	}
+	return last
+}
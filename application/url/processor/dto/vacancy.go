@@ -28,21 +28,47 @@ func newVacancyPool() func() *sync.Pool {
 
 // Vacancy represents a job vacancy with details.
 type Vacancy struct {
-	Title       string    // The title of the job vacancy.
-	Company     string    // The company offering the job vacancy.
-	Description string    // A brief description of the job vacancy.
-	PostedAt    time.Time // The timestamp when the job was posted.
-	Location    string    // The location of the job vacancy.
-	SentAt      time.Time // The timestamp when the vacancy was sent.
+	SourceName         string                // The name of the source the vacancy was crawled from.
+	SourceURL          string                // The address of the page the vacancy was parsed from.
+	ExternalID         string                // The identifier the board assigned to the vacancy.
+	Title              string                // The title of the job vacancy.
+	Company            string                // The company offering the job vacancy.
+	Description        string                // A brief description of the job vacancy.
+	DescriptionHTML    string                // The sanitised HTML the description was converted from.
+	PostedAt           time.Time             // The timestamp when the job was posted.
+	PostedAtConfidence entity.DateConfidence // How reliably the posting timestamp was extracted.
+	Location           string                // The location of the job vacancy.
+	Salary             *entity.Salary        // The compensation offered, nil when not announced.
+	Skills             []string              // The normalised tags of the skills the vacancy mentions.
+	Seniority          entity.Seniority      // The experience level the vacancy is aimed at.
+	EmploymentType     entity.EmploymentType // The kind of contract the vacancy is offered under.
+	WorkMode           entity.WorkMode       // Whether the work is remote, hybrid or onsite.
+	Places             []entity.Place        // The normalised locations parsed from Location.
+	Language           string                // The ISO 639-1 code of the language the vacancy is written in.
+	LanguageConfidence float64               // How sure, from 0 to 1, the detector is about Language.
+	SentAt             time.Time             // The timestamp when the vacancy was sent.
 }
 
 // Reset clears all fields of the Vacancy object and resets them to their zero values.
 func (v *Vacancy) Reset() *Vacancy {
+	v.SourceName = ""
+	v.SourceURL = ""
+	v.ExternalID = ""
 	v.Title = ""
 	v.Company = ""
 	v.Description = ""
+	v.DescriptionHTML = ""
 	v.PostedAt = time.Time{}
+	v.PostedAtConfidence = ""
 	v.Location = ""
+	v.Salary = nil
+	v.Skills = nil
+	v.Seniority = ""
+	v.EmploymentType = ""
+	v.WorkMode = ""
+	v.Places = nil
+	v.Language = ""
+	v.LanguageConfidence = 0
 	v.SentAt = time.Time{}
 	return v
 }
@@ -60,10 +86,22 @@ func GetVacancy() *Vacancy {
 
 // ToEntity maps the fields of the Vacancy object to an entity.Vacancy object.
 func (v *Vacancy) ToEntity(e *entity.Vacancy) {
+	e.SourceName = v.SourceName
+	e.SourceURL = v.SourceURL
+	e.ExternalID = v.ExternalID
 	e.Title = v.Title
 	e.Company = v.Company
 	e.Description = v.Description
+	e.DescriptionHTML = v.DescriptionHTML
 	e.PostedAt = v.PostedAt
+	e.PostedAtConfidence = v.PostedAtConfidence
 	e.Location = v.Location
+	e.Salary = v.Salary
+	e.Skills = v.Skills
+	e.Seniority = v.Seniority
+	e.EmploymentType = v.EmploymentType
+	e.WorkMode = v.WorkMode
+	e.Places = v.Places
+	e.Language, e.LanguageConfidence = v.Language, v.LanguageConfidence
 	e.SentAt = v.SentAt
 }
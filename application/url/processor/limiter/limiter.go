@@ -0,0 +1,36 @@
+package limiter
+
+import "context"
+
+// Limiter caps the number of simultaneous operations shared between all of its users.
+type Limiter struct {
+	slots chan struct{} // slots holds one token per operation in progress.
+}
+
+// NewLimiter creates a new Limiter that allows up to size simultaneous operations.
+func NewLimiter(size int) *Limiter {
+	if size <= 0 {
+		size = 1
+	}
+	return &Limiter{slots: make(chan struct{}, size)}
+}
+
+// Acquire blocks until a slot is available or the context is done.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot previously taken with Acquire.
+func (l *Limiter) Release() {
+	<-l.slots
+}
+
+// InUse returns the number of slots currently taken.
+func (l *Limiter) InUse() int {
+	return len(l.slots)
+}
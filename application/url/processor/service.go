@@ -1,45 +1,151 @@
 package processor
 
 import (
+	"application/report"
 	"application/source"
 	"context"
+	runEntity "domain/run/entity"
+	runRepository "domain/run/repository"
+	domainSource "domain/source"
 	"fmt"
+	"sync"
+	"time"
 )
 
+// saveTimeout is the maximum time spent persisting a run report.
+const saveTimeout = 10 * time.Second
+
 // Service orchestrates the processing of URLs by managing multiple sources.
 type Service struct {
-	factory   *source.Factory // Factory for managing source handlers.
-	batchSize int             // Batch size for processing.
+	factory   *source.Factory             // Factory for managing source handlers.
+	batchSize int                         // Batch size for processing.
+	timeout   time.Duration               // Maximum time a single source may run before it is abandoned.
+	runs      runRepository.RunRepository // Repository storing run reports, reports are only printed when nil.
 }
 
 // NewService creates and initializes a new Service instance.
-func NewService(factory *source.Factory, batchSize int) *Service {
-	return &Service{factory: factory, batchSize: batchSize}
+func NewService(
+	factory *source.Factory,
+	batchSize int,
+	timeout time.Duration,
+	runs runRepository.RunRepository,
+) *Service {
+	return &Service{factory: factory, batchSize: batchSize, timeout: timeout, runs: runs}
 }
 
-// Run iterates through all registered sources and processes their URLs and HTML content.
+// Run processes all registered sources concurrently and waits until each of them completes or is abandoned.
+// A single report covering all sources is printed and stored afterwards.
 func (s *Service) Run(ctx context.Context) {
-	for {
-		fmt.Println("Starting to process all registered sources.")
-		sources := s.factory.GetAllHandlers()
-
-		for name, handler := range sources {
-			fmt.Printf("Processing source: %s\n", name)
+	var (
+		wg  sync.WaitGroup
+		rep = report.NewReport()
+	)
 
-			// Process URLs for the source.
-			if err := handler.ProcessURLs(ctx); err != nil {
-				fmt.Printf("Error processing URLs for source %s: %v\n", name, err)
-				continue
+	fmt.Println("Starting to process all registered sources.")
+	for name, handler := range s.factory.GetAllHandlers() {
+		wg.Add(1)
+		go func(name string, handler domainSource.Handler) {
+			defer wg.Done()
+			if err := s.runSource(ctx, rep.Source(name), name, handler); err != nil {
+				fmt.Printf("Error processing source %s: %v\n", name, err)
+				return
 			}
+			fmt.Printf("Finished processing source: %s\n", name)
+		}(name, handler)
+	}
+	wg.Wait()
 
-			// Process HTML for the source in batches.
-			if err := handler.ProcessHTML(ctx, s.batchSize); err != nil {
-				fmt.Printf("Error processing HTML for source %s: %v\n", name, err)
+	// Done.
+	fmt.Println("Finished processing all registered sources.")
+	s.finish(ctx, rep.Run())
+}
+
+// RunSource processes the URLs and HTML content of a single source in isolation and reports the run.
+// A panic inside the handler is recovered and returned as an error. A handler that does not return
+// within the configured timeout is abandoned, so it cannot stall the caller or other sources.
+// When ctx itself is cancelled the handler is waited for instead, so it can drain its in-flight work.
+func (s *Service) RunSource(ctx context.Context, name string, handler domainSource.Handler) error {
+	rep := report.NewReport()
+	err := s.runSource(ctx, rep.Source(name), name, handler)
+	s.finish(ctx, rep.Run())
+	return err
+}
+
+// runSource runs a single source, collecting its statistics into stats.
+func (s *Service) runSource(
+	ctx context.Context,
+	stats *report.Source,
+	name string,
+	handler domainSource.Handler,
+) (err error) {
+	sourceCtx, cancel := s.sourceContext(ctx)
+	defer cancel()
+	defer func() {
+		stats.AddError(err)
+		stats.Finish()
+	}()
+
+	fmt.Printf("Processing source: %s\n", name)
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("recovered from panic: %v", r)
 			}
+		}()
+		done <- s.process(report.WithSource(sourceCtx, stats), handler)
+	}()
+
+	select {
+	case err = <-done:
+		return err
+	case <-sourceCtx.Done():
+		if ctx.Err() != nil {
+			return <-done
 		}
+		// The handler may have finished at the same moment the deadline fired.
+		select {
+		case err = <-done:
+			return err
+		default:
+			return fmt.Errorf("source abandoned: %w", sourceCtx.Err())
+		}
+	}
+}
+
+// process runs the URL discovery and HTML processing steps of a source.
+func (s *Service) process(ctx context.Context, handler domainSource.Handler) error {
+	// Process URLs for the source.
+	if err := handler.ProcessURLs(ctx); err != nil {
+		return fmt.Errorf("process URLs: %w", err)
+	}
+
+	// Process HTML for the source in batches.
+	if err := handler.ProcessHTML(ctx, s.batchSize); err != nil {
+		return fmt.Errorf("process HTML: %w", err)
+	}
+	return nil
+}
+
+// finish prints the summary of a run and stores it.
+// The report is stored even when ctx is already cancelled, so interrupted runs are recorded too.
+func (s *Service) finish(ctx context.Context, run *runEntity.Run) {
+	fmt.Print(report.Summary(run))
+	if s.runs == nil {
+		return
+	}
+
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), saveTimeout)
+	defer cancel()
+	if err := s.runs.Save(saveCtx, run); err != nil {
+		fmt.Printf("[WARN] save run report: %v\n", err)
+	}
+}
 
-		// Done.
-		fmt.Println("Finished processing all registered sources.")
-		break
+// sourceContext derives the context a single source runs with.
+func (s *Service) sourceContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.timeout <= 0 {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeout(ctx, s.timeout)
 }
@@ -1,13 +1,17 @@
 package sitemap
 
 import (
+	"application/report"
 	"context"
+	"domain/url/entity"
 	"fmt"
 	"infrastructure/url/sitemap/fetcher"
 	"infrastructure/url/sitemap/notifier"
+	"infrastructure/url/sitemap/parser"
 	"infrastructure/url/sitemap/repository"
 	"io"
 	"net/http"
+	"time"
 )
 
 // Parser defines the contract for parser.
@@ -15,6 +19,11 @@ type Parser interface {
 	Parse(body io.Reader) ([]string, error)
 }
 
+// EntryParser is implemented by parsers of feeds that announce when each URL was published.
+type EntryParser interface {
+	ParseEntries(body io.Reader) ([]parser.Entry, error)
+}
+
 // Option defines a functional option for configuring the Sitemap Service.
 type Option func(service *Service)
 
@@ -72,38 +81,78 @@ func WithParser(parser Parser) Option {
 	}
 }
 
-// ProcessUrls orchestrates the complete flow of fetching, parsing, notifying, and saving URLs.
-func (s *Service) ProcessUrls(ctx context.Context, url string) error {
+// ProcessUrls orchestrates the complete flow of fetching, parsing, notifying, and saving URLs of the given source.
+// When delistAfter is positive, stored URLs the sitemap missed in delistAfter consecutive runs are delisted
+// and returned, so that their vacancies can be closed without fetching them.
+func (s *Service) ProcessUrls(ctx context.Context, source, url string, delistAfter int) ([]*entity.Url, error) {
 	// Notify (log the proxy's IP address).
 	client, err := s.client()
 	if err != nil {
-		return fmt.Errorf("get client: %w", err)
+		return nil, fmt.Errorf("get client: %w", err)
 	}
 	if err = s.notifier.Notify(ctx, client); err != nil {
-		return fmt.Errorf("notify: %w", err)
+		return nil, fmt.Errorf("notify: %w", err)
+	}
+
+	// Fetch and parse the content of the URL.
+	seenAt := time.Now()
+	entries, err := s.download(ctx, url)
+	if err != nil {
+		return nil, err
 	}
 
-	// Fetch content from the URL.
+	// Save the extracted URLs to the data source.
+	saved, err := s.repo.SaveEntries(ctx, source, entries)
+	report.FromContext(ctx).AddDiscovered(len(entries), saved)
+	if err != nil {
+		return nil, fmt.Errorf("save urls: %w", err)
+	}
+
+	// Diff against the previous runs, unless the sitemap came back empty, which more likely means it broke.
+	if delistAfter <= 0 || len(entries) == 0 {
+		return nil, nil
+	}
+	delisted, err := s.repo.Delist(ctx, source, entries, seenAt, delistAfter)
+	if err != nil {
+		return nil, fmt.Errorf("delist urls: %w", err)
+	}
+	report.FromContext(ctx).AddDelisted(len(delisted))
+	return delisted, nil
+}
+
+// download fetches the sitemap and extracts its entries. The body is closed before returning, which frees the
+// request slot shared with other outbound requests before the entries are stored.
+func (s *Service) download(ctx context.Context, url string) ([]parser.Entry, error) {
 	body, err := s.fetcher.Fetch(ctx, url)
 	if err != nil {
-		return fmt.Errorf("fetch url: %w", err)
+		return nil, fmt.Errorf("fetch url: %w", err)
 	}
 	defer func() {
-		if err = body.Close(); err != nil {
-			fmt.Printf("close body err:%v", err)
+		if cErr := body.Close(); cErr != nil {
+			fmt.Printf("close body err:%v", cErr)
 		}
 	}()
 
-	// Parse the fetched content to extract URLs.
-	urls, err := s.parser.Parse(body)
+	entries, err := s.parse(body)
 	if err != nil {
-		return fmt.Errorf("parse urls: %w", err)
+		return nil, fmt.Errorf("parse urls: %w", err)
 	}
+	return entries, nil
+}
 
-	// Save the extracted URLs to the data source.
-	if err = s.repo.SaveUrls(ctx, urls); err != nil {
-		return fmt.Errorf("save urls: %w", err)
+// parse extracts the entries from the fetched content, with publication dates when the parser provides them.
+func (s *Service) parse(body io.Reader) ([]parser.Entry, error) {
+	if entryParser, ok := s.parser.(EntryParser); ok {
+		return entryParser.ParseEntries(body)
 	}
 
-	return nil
+	urls, err := s.parser.Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]parser.Entry, len(urls))
+	for i, url := range urls {
+		entries[i] = parser.Entry{URL: url}
+	}
+	return entries, nil
 }
@@ -0,0 +1,77 @@
+package dedup
+
+import (
+	"context"
+	"domain/vacancy/entity"
+	"domain/vacancy/repository"
+	"fmt"
+	"infrastructure/fingerprint"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Service links the vacancies posted on several boards into clusters of near-duplicates.
+// Vacancies share a cluster when their normalised title, company and location match and the SimHashes of their
+// descriptions differ in at most maxDistance bits. The first vacancy of a cluster is its canonical record.
+type Service struct {
+	repository  repository.VacancyRepository // Repository storing the vacancies.
+	maxDistance int                          // Number of bits the SimHashes of near-duplicates may differ in.
+	candidates  int                          // Number of vacancies sharing the fingerprint compared at most.
+}
+
+// NewService creates and returns a new Service instance.
+func NewService(repository repository.VacancyRepository, maxDistance, candidates int) *Service {
+	return &Service{repository: repository, maxDistance: maxDistance, candidates: max(candidates, 1)}
+}
+
+// Assign fingerprints the vacancy before it is saved and links it to the cluster of a stored near-duplicate,
+// or makes it the canonical record of a new cluster. It assigns the vacancy its ID if it has none yet.
+func (s *Service) Assign(ctx context.Context, v *entity.Vacancy) error {
+	if v.ID.IsZero() {
+		v.ID = primitive.NewObjectID()
+	}
+	s.Fingerprint(v)
+	hash := uint64(v.SimHash)
+	v.ClusterID = v.ID.Hex()
+
+	candidates, err := s.repository.FetchByFingerprint(ctx, v.Fingerprint, s.candidates)
+	if err != nil {
+		return fmt.Errorf("fetch vacancies by fingerprint: %w", err)
+	}
+	for _, candidate := range candidates {
+		if candidate.ID == v.ID || fingerprint.Distance(uint64(candidate.SimHash), hash) > s.maxDistance {
+			continue
+		}
+		v.ClusterID = candidate.ClusterID
+		if v.ClusterID == "" {
+			v.ClusterID = candidate.ID.Hex()
+		}
+		return nil
+	}
+	return nil
+}
+
+// Fingerprint computes the fingerprint and the SimHash of the vacancy without changing its cluster.
+func (s *Service) Fingerprint(v *entity.Vacancy) {
+	v.Fingerprint = fingerprint.Key(v.Title, company(v), location(v))
+	v.SimHash = int64(fingerprint.SimHash(v.Description))
+}
+
+// company returns the company part of the fingerprint, preferring the company ID shared by all its spellings.
+func company(v *entity.Vacancy) string {
+	if v.CompanyID != "" {
+		return v.CompanyID
+	}
+	return v.Company
+}
+
+// location returns the location part of the fingerprint, preferring the first normalised city, as boards
+// spell cities and announce remote work differently.
+func location(v *entity.Vacancy) string {
+	for _, place := range v.Places {
+		if place.City != "" {
+			return place.City + " " + place.Country
+		}
+	}
+	return v.Location
+}
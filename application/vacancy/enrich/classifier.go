@@ -0,0 +1,33 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"infrastructure/classify"
+)
+
+// Classifier derives the seniority, employment type and work mode of the vacancy from its text, keeping the
+// values the parser took from structured data.
+type Classifier struct{}
+
+// NewClassifier creates and returns a new Classifier instance.
+func NewClassifier() *Classifier { return &Classifier{} }
+
+// Name returns the name identifying the enricher.
+func (c *Classifier) Name() string { return "classify" }
+
+// Version returns the version of the enrichment rules.
+func (c *Classifier) Version() string { return "1" }
+
+// Enrich fills in the seniority, employment type and work mode the parser left empty.
+// It runs after the salary enricher, so that the contract announced with the salary is taken into account.
+func (c *Classifier) Enrich(v *dto.Vacancy) {
+	if v.Seniority == "" {
+		v.Seniority = classify.Seniority(v.Title, v.Description)
+	}
+	if v.EmploymentType == "" {
+		v.EmploymentType = classify.EmploymentType(v.Salary, v.Title, v.Description)
+	}
+	if v.WorkMode == "" {
+		v.WorkMode = classify.WorkMode(v.Location, v.Title, v.Description)
+	}
+}
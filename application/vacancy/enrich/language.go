@@ -0,0 +1,27 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"infrastructure/language"
+)
+
+// Language detects the language the vacancy is written in from its title and description.
+type Language struct {
+	detector *language.Detector // The detector comparing the text with the embedded language profiles.
+}
+
+// NewLanguage creates and returns a new Language instance.
+func NewLanguage(detector *language.Detector) *Language {
+	return &Language{detector: detector}
+}
+
+// Name returns the name identifying the enricher.
+func (l *Language) Name() string { return "language" }
+
+// Version returns the version of the enrichment rules, following the version of the profiles.
+func (l *Language) Version() string { return "1." + language.Version }
+
+// Enrich fills in the language of the vacancy and the confidence of the detection.
+func (l *Language) Enrich(v *dto.Vacancy) {
+	v.Language, v.LanguageConfidence = l.detector.Detect(v.Title, v.Description)
+}
@@ -0,0 +1,62 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"domain/html"
+	"strings"
+	"time"
+)
+
+// Enricher derives additional vacancy fields from the fields a parser extracted.
+type Enricher interface {
+	// Name returns the name identifying the enricher.
+	Name() string
+
+	// Version returns the version of the enrichment rules, changed whenever the enricher output changes.
+	Version() string
+
+	// Enrich fills in the fields derived by the enricher.
+	Enrich(v *dto.Vacancy)
+}
+
+// Parser runs a chain of enrichers, in order, on every vacancy produced by the wrapped parser.
+type Parser struct {
+	parser    html.Parser // The parser producing the vacancies.
+	enrichers []Enricher  // The enrichers applied to every parsed vacancy.
+}
+
+// NewParser creates and returns a new Parser instance.
+func NewParser(parser html.Parser, enrichers ...Enricher) *Parser {
+	return &Parser{parser: parser, enrichers: enrichers}
+}
+
+// Name returns the name of the wrapped parser, so stored vacancies keep their parser name.
+func (p *Parser) Name() string { return p.parser.Name() }
+
+// Version returns the version of the wrapped parser combined with the version of every enricher,
+// so vacancies are re-parsed whenever the enrichment rules change.
+func (p *Parser) Version() string {
+	parts := []string{p.parser.Version()}
+	for _, e := range p.enrichers {
+		parts = append(parts, e.Name()+"."+e.Version())
+	}
+	return strings.Join(parts, "+")
+}
+
+// Parse parses the provided raw HTML content and enriches the extracted vacancy.
+func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
+	return p.ParseAt(htmlContent, time.Now())
+}
+
+// ParseAt parses the provided raw HTML content fetched at the given time and enriches the extracted vacancy.
+func (p *Parser) ParseAt(htmlContent string, fetchedAt time.Time) (*dto.Vacancy, error) {
+	v, err := p.parser.ParseAt(htmlContent, fetchedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range p.enrichers {
+		e.Enrich(v)
+	}
+	return v, nil
+}
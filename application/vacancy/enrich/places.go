@@ -0,0 +1,27 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"infrastructure/geo"
+)
+
+// Places normalises the raw location of the vacancy into places with country and coordinates.
+type Places struct {
+	parser *geo.Parser // The parser resolving locations against the offline gazetteer.
+}
+
+// NewPlaces creates and returns a new Places instance.
+func NewPlaces(parser *geo.Parser) *Places {
+	return &Places{parser: parser}
+}
+
+// Name returns the name identifying the enricher.
+func (p *Places) Name() string { return "places" }
+
+// Version returns the version of the enrichment rules, which should change along with the gazetteer.
+func (p *Places) Version() string { return "3" }
+
+// Enrich fills in the places of the vacancy.
+func (p *Places) Enrich(v *dto.Vacancy) {
+	v.Places = p.parser.Parse(v.Location)
+}
@@ -0,0 +1,31 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"infrastructure/salary"
+)
+
+// Salary extracts the salary from the vacancy text when the parser found none, and normalises it to an annual
+// amount in the reference currency.
+type Salary struct {
+	normalizer *salary.Normalizer // The normalizer converting salaries into the reference currency.
+}
+
+// NewSalary creates and returns a new Salary instance.
+func NewSalary(normalizer *salary.Normalizer) *Salary {
+	return &Salary{normalizer: normalizer}
+}
+
+// Name returns the name identifying the enricher.
+func (s *Salary) Name() string { return "salary" }
+
+// Version returns the version of the enrichment rules.
+func (s *Salary) Version() string { return "2" }
+
+// Enrich fills in the salary of the vacancy.
+func (s *Salary) Enrich(v *dto.Vacancy) {
+	if v.Salary == nil {
+		v.Salary = salary.Parse(v.Title + "\n" + v.Description)
+	}
+	s.normalizer.Normalize(v.Salary)
+}
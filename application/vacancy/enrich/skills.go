@@ -0,0 +1,27 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"infrastructure/skills"
+)
+
+// Skills tags the vacancy with the skills its title and description mention.
+type Skills struct {
+	extractor *skills.Extractor // The extractor finding skills in text.
+}
+
+// NewSkills creates and returns a new Skills instance.
+func NewSkills(extractor *skills.Extractor) *Skills {
+	return &Skills{extractor: extractor}
+}
+
+// Name returns the name identifying the enricher.
+func (s *Skills) Name() string { return "skills" }
+
+// Version returns the version of the enrichment rules, following the version of the taxonomy.
+func (s *Skills) Version() string { return "1." + s.extractor.Version() }
+
+// Enrich fills in the skills of the vacancy.
+func (s *Skills) Enrich(v *dto.Vacancy) {
+	v.Skills = s.extractor.Extract(v.Title, v.Description)
+}
@@ -0,0 +1,242 @@
+package reparse
+
+import (
+	"application/company"
+	"application/source"
+	"application/vacancy/store"
+	"context"
+	"domain/html"
+	snapshotRepository "domain/snapshot/repository"
+	"domain/vacancy/entity"
+	vacancyRepository "domain/vacancy/repository"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Options controls a re-parse run.
+type Options struct {
+	All    bool     // All re-parses every vacancy of the parser, not only those produced by older versions.
+	DryRun bool     // DryRun reports the differences without updating any vacancy.
+	Hosts  []string // Hosts the pages of the source are served from, matching vacancies stored without a parser.
+}
+
+// Result summarises a re-parse run.
+type Result struct {
+	Checked   int // Number of vacancies examined.
+	Changed   int // Number of vacancies whose extracted fields changed.
+	Unchanged int // Number of vacancies that only had their parser version bumped.
+	Missing   int // Number of vacancies without a stored snapshot.
+	Failed    int // Number of snapshots the parser could not parse.
+}
+
+// Diff describes a field whose value differs between the stored and the re-parsed vacancy.
+type Diff struct {
+	Field string // Name of the field.
+	Old   string // Stored value.
+	New   string // Re-parsed value.
+}
+
+// Service rebuilds stored vacancies by replaying their raw page snapshots through the current parser.
+// Vacancies are updated through the same store as crawled ones, so that they are fingerprinted and linked to
+// their near-duplicates again.
+type Service struct {
+	vacancies vacancyRepository.VacancyRepository   // Repository storing the vacancies.
+	snapshots snapshotRepository.SnapshotRepository // Repository storing the raw page snapshots.
+	store     *store.Service                        // Service updating the vacancies.
+	companies *company.Service                      // Service linking vacancies to the companies offering them.
+	batchSize int                                   // Number of vacancies loaded at once.
+}
+
+// NewService creates and returns a new Service instance.
+func NewService(
+	vacancies vacancyRepository.VacancyRepository,
+	snapshots snapshotRepository.SnapshotRepository,
+	store *store.Service,
+	companies *company.Service,
+	batchSize int,
+) *Service {
+	return &Service{
+		vacancies: vacancies,
+		snapshots: snapshots,
+		store:     store,
+		companies: companies,
+		batchSize: max(batchSize, 1),
+	}
+}
+
+// Run re-parses the vacancies produced by the given parser.
+// Changed vacancies are updated and flagged for re-send by clearing their SentAt field.
+func (s *Service) Run(ctx context.Context, parser html.Parser, opts Options) (result Result, err error) {
+	var (
+		version = parser.Version()
+		afterID string
+		batch   []*entity.Vacancy
+	)
+	if opts.All {
+		version = ""
+	}
+
+	for {
+		batch, err = s.vacancies.FetchOutdated(ctx, parser.Name(), version, opts.Hosts, afterID, s.batchSize)
+		if err != nil {
+			return result, fmt.Errorf("fetch vacancies: %w", err)
+		}
+		if len(batch) == 0 {
+			return result, nil
+		}
+
+		for _, vacancy := range batch {
+			afterID = vacancy.ID.Hex()
+			result.Checked++
+			if err = s.reparse(ctx, parser, vacancy, opts, &result); err != nil {
+				return result, fmt.Errorf("reparse vacancy %s: %w", afterID, err)
+			}
+		}
+	}
+}
+
+// reparse rebuilds a single vacancy from its snapshot and stores the outcome.
+func (s *Service) reparse(
+	ctx context.Context,
+	parser html.Parser,
+	vacancy *entity.Vacancy,
+	opts Options,
+	result *Result,
+) error {
+	if vacancy.SnapshotID == "" {
+		result.Missing++
+		return nil
+	}
+	snapshot, err := s.snapshots.FindByID(ctx, vacancy.SnapshotID)
+	if err != nil {
+		fmt.Printf("[WARN] snapshot of vacancy %s: %v\n", vacancy.ID.Hex(), err)
+		result.Missing++
+		return nil
+	}
+
+	parsed, err := parser.ParseAt(snapshot.Body, snapshot.FetchedAt)
+	if err != nil {
+		fmt.Printf("[WARN] parse snapshot of vacancy %s: %v\n", vacancy.ID.Hex(), err)
+		result.Failed++
+		return nil
+	}
+	defer parsed.Release()
+
+	fresh := &entity.Vacancy{}
+	parsed.ToEntity(fresh)
+
+	updated := *vacancy
+	updated.Title, updated.Company = fresh.Title, fresh.Company
+	updated.Description, updated.Location = fresh.Description, fresh.Location
+	updated.DescriptionHTML, updated.Salary = fresh.DescriptionHTML, fresh.Salary
+	updated.Skills = fresh.Skills
+	updated.Seniority, updated.EmploymentType, updated.WorkMode = fresh.Seniority, fresh.EmploymentType, fresh.WorkMode
+	updated.Places = fresh.Places
+	updated.Language, updated.LanguageConfidence = fresh.Language, fresh.LanguageConfidence
+	if fresh.ExternalID != "" {
+		updated.ExternalID = fresh.ExternalID
+	}
+
+	// Backfill the provenance of vacancies stored before it was recorded.
+	if updated.SourceName == "" {
+		updated.SourceName = parser.Name()
+	}
+	if updated.SourceURL == "" {
+		updated.SourceURL = snapshot.URL
+	}
+	if updated.ExternalID == "" {
+		updated.ExternalID = source.ExternalID(updated.SourceURL)
+	}
+	updated.SetPostedAt(fresh.PostedAt, fresh.PostedAtConfidence)
+	updated.Parser, updated.ParserVersion = parser.Name(), parser.Version()
+
+	diffs := Compare(vacancy, &updated)
+	if len(diffs) == 0 {
+		result.Unchanged++
+	} else {
+		result.Changed++
+		fmt.Printf("vacancy %s (%s):\n", vacancy.ID.Hex(), snapshot.URL)
+		for _, d := range diffs {
+			fmt.Printf("  %s: %q -> %q\n", d.Field, d.Old, d.New)
+		}
+
+		// Flag for re-send and re-evaluate the quality gate with the new data.
+		updated.SentAt, updated.UpdatedAt = time.Time{}, time.Now()
+		updated.Quarantined, updated.QuarantineReason = false, ""
+		if vErr := updated.Validate(); vErr != nil {
+			updated.Quarantine(vErr.Error())
+		}
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+
+	// The company, and so the fingerprint and cluster, follow the re-parsed company name.
+	if updated.Company != vacancy.Company {
+		updated.CompanyID = s.resolveCompany(ctx, &updated, snapshot.FetchedAt)
+	}
+	if err = s.store.Update(ctx, vacancy, &updated); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// resolveCompany returns the ID of the company offering the vacancy, or an empty string if it is unknown.
+// Resolving companies is best effort, failures are reported and leave the vacancy without a company.
+func (s *Service) resolveCompany(ctx context.Context, vacancy *entity.Vacancy, seenAt time.Time) string {
+	employer, err := s.companies.Resolve(ctx, vacancy.Company, seenAt)
+	if err != nil {
+		fmt.Printf("[WARN] resolve company of vacancy %s: %v\n", vacancy.ID.Hex(), err)
+		return ""
+	}
+	if employer == nil {
+		return ""
+	}
+	return employer.ID.Hex()
+}
+
+// Compare returns the extracted fields whose values differ between two vacancies.
+func Compare(old, updated *entity.Vacancy) []Diff {
+	fields := []Diff{
+		{Field: "title", Old: old.Title, New: updated.Title},
+		{Field: "company", Old: old.Company, New: updated.Company},
+		{Field: "description", Old: old.Description, New: updated.Description},
+		{Field: "description_html", Old: old.DescriptionHTML, New: updated.DescriptionHTML},
+		{Field: "location", Old: old.Location, New: updated.Location},
+		{Field: "posted_at", Old: formatDate(old.PostedAt), New: formatDate(updated.PostedAt)},
+		{Field: "salary", Old: old.Salary.String(), New: updated.Salary.String()},
+		{Field: "skills", Old: strings.Join(old.Skills, ","), New: strings.Join(updated.Skills, ",")},
+		{Field: "seniority", Old: string(old.Seniority), New: string(updated.Seniority)},
+		{Field: "employment_type", Old: string(old.EmploymentType), New: string(updated.EmploymentType)},
+		{Field: "work_mode", Old: string(old.WorkMode), New: string(updated.WorkMode)},
+		{Field: "places", Old: formatPlaces(old.Places), New: formatPlaces(updated.Places)},
+		{Field: "language", Old: old.Language, New: updated.Language},
+	}
+
+	var diffs []Diff
+	for _, field := range fields {
+		if field.Old != field.New {
+			diffs = append(diffs, field)
+		}
+	}
+	return diffs
+}
+
+// formatPlaces formats places for comparison.
+func formatPlaces(places []entity.Place) string {
+	formatted := make([]string, len(places))
+	for i, place := range places {
+		formatted[i] = place.String()
+	}
+	return strings.Join(formatted, "; ")
+}
+
+// formatDate formats a date for comparison, the zero time is formatted as an empty string.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"application/vacancy/dedup"
+	"context"
+	"domain/vacancy/entity"
+	"domain/vacancy/repository"
+	"fmt"
+	"time"
+)
+
+// Outcome tells what saving a vacancy did to the stored record.
+type Outcome string
+
+const (
+	OutcomeCreated   Outcome = "created"   // The vacancy was not stored yet.
+	OutcomeUpdated   Outcome = "updated"   // The stored vacancy was replaced as its content changed.
+	OutcomeUnchanged Outcome = "unchanged" // The stored vacancy has the same content, only its crawl was recorded.
+	OutcomeKept      Outcome = "kept"      // The vacancy failed validation, the valid stored one was kept.
+)
+
+// Service stores crawled vacancies, keyed by their source and external ID or URL, so that re-crawling a page
+// updates the stored vacancy instead of adding a new one.
+type Service struct {
+	repository repository.VacancyRepository // Repository storing the vacancies.
+	dedup      *dedup.Service               // Service linking new vacancies to their near-duplicates.
+}
+
+// NewService creates and returns a new Service instance.
+func NewService(repository repository.VacancyRepository, dedup *dedup.Service) *Service {
+	return &Service{repository: repository, dedup: dedup}
+}
+
+// Save stores the vacancy and reports whether it was created, updated, unchanged or kept.
+// A changed vacancy keeps the identity, cluster and remote ID of the stored one and is flagged to be sent again.
+// A closed vacancy found open again counts as changed. An unchanged vacancy only gets the snapshot, parser and
+// update time of the crawl recorded, and a quarantined vacancy never replaces a valid stored one.
+// Linking new vacancies to their near-duplicates is best effort, failures are reported but do not stop saving.
+func (s *Service) Save(ctx context.Context, v *entity.Vacancy) (Outcome, error) {
+	v.ContentHash = v.Hash()
+
+	stored, err := s.repository.FindBySource(ctx, v.SourceName, v.ExternalID, v.SourceURL)
+	if err != nil {
+		return "", fmt.Errorf("find stored vacancy: %w", err)
+	}
+
+	switch {
+	case stored == nil:
+		s.assign(ctx, v)
+		v.UpdatedAt = time.Now()
+		if err = s.repository.Save(ctx, v); err != nil {
+			return "", fmt.Errorf("save vacancy: %w", err)
+		}
+		return OutcomeCreated, nil
+
+	case v.Quarantined && !stored.Quarantined:
+		// A broken extraction of a valid vacancy, e.g. after a layout change, must not replace it.
+		v.ID = stored.ID
+		return OutcomeKept, nil
+
+	case stored.ContentHash == v.ContentHash && stored.Quarantined == v.Quarantined && !stored.Closed():
+		v.ID = stored.ID
+		if v.SnapshotID != "" {
+			stored.SnapshotID = v.SnapshotID
+		}
+		stored.Parser, stored.ParserVersion, stored.UpdatedAt = v.Parser, v.ParserVersion, time.Now()
+		if err = s.repository.Update(ctx, stored); err != nil {
+			return "", fmt.Errorf("update vacancy: %w", err)
+		}
+		return OutcomeUnchanged, nil
+
+	default:
+		v.SentAt, v.UpdatedAt = time.Time{}, time.Now()
+		if err = s.Update(ctx, stored, v); err != nil {
+			return "", fmt.Errorf("%w", err)
+		}
+		return OutcomeUpdated, nil
+	}
+}
+
+// Update replaces the stored vacancy with its new version v, keeping the identity, cluster and remote ID of
+// the stored one. The content hash and fingerprint of v are computed again, and v is linked to its
+// near-duplicates again when its fingerprint changed, e.g. as its title was extracted differently.
+func (s *Service) Update(ctx context.Context, stored, v *entity.Vacancy) error {
+	v.ID, v.ClusterID, v.RemoteID = stored.ID, stored.ClusterID, stored.RemoteID
+	v.ContentHash = v.Hash()
+	if s.dedup.Fingerprint(v); v.Fingerprint != stored.Fingerprint {
+		v.ClusterID = ""
+	}
+	s.assign(ctx, v)
+	if err := s.repository.Update(ctx, v); err != nil {
+		return fmt.Errorf("update vacancy: %w", err)
+	}
+	return nil
+}
+
+// Close marks the stored vacancy of the named source found at sourceURL as closed by the board.
+// Returns false if no such vacancy is stored, vacancies already closed keep the time they were first found closed.
+func (s *Service) Close(ctx context.Context, source, sourceURL string, closedAt time.Time) (bool, error) {
+	stored, err := s.repository.FindBySource(ctx, source, "", sourceURL)
+	if err != nil {
+		return false, fmt.Errorf("find stored vacancy: %w", err)
+	}
+	if stored == nil {
+		return false, nil
+	}
+	if stored.Closed() {
+		return true, nil
+	}
+
+	stored.ClosedAt = closedAt
+	if err = s.repository.Update(ctx, stored); err != nil {
+		return false, fmt.Errorf("update vacancy: %w", err)
+	}
+	return true, nil
+}
+
+// assign fingerprints the vacancy and links it to a cluster unless it already belongs to one.
+// Quarantined vacancies stay out of clusters until they pass validation.
+func (s *Service) assign(ctx context.Context, v *entity.Vacancy) {
+	if v.Quarantined || v.ClusterID != "" {
+		s.dedup.Fingerprint(v)
+		return
+	}
+	if err := s.dedup.Assign(ctx, v); err != nil {
+		fmt.Printf("[WARN] failed to deduplicate vacancy %s: %v\n", v.SourceURL, err)
+	}
+}
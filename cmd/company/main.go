@@ -0,0 +1,51 @@
+package main
+
+import (
+	"application"
+	"context"
+	"domain/company/entity"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// statuses maps the accepted status flag values to company statuses.
+var statuses = map[string]entity.Status{
+	"blocked": entity.StatusBlocked,
+	"allowed": entity.StatusAllowed,
+	"none":    entity.StatusNone,
+}
+
+// run sets the status of the named company.
+func run(ctx context.Context, c *application.Container, name, value string) error {
+	status, ok := statuses[value]
+	if !ok {
+		return fmt.Errorf("unknown status %q, expected blocked, allowed or none", value)
+	}
+
+	company, err := c.CompanyService.Get().SetStatus(ctx, name, status)
+	if err != nil {
+		return fmt.Errorf("set status: %w", err)
+	}
+	log.Printf("Company %s (%s), known as %v, is now %q.", company.Key, company.ID.Hex(), company.Aliases, value)
+	return nil
+}
+
+// main is the entry point for the company command.
+func main() {
+	var (
+		name   = flag.String("name", "", "Name of the company, in any of its spellings")
+		status = flag.String("status", "", "Status of the company: blocked, allowed or none")
+	)
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx, application.NewContainer(), *name, *status)
+	cancel()
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
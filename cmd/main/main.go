@@ -2,13 +2,17 @@ package main
 
 import (
 	"application"
+	"application/source/alfa"
+	"application/source/beta"
 	"context"
 	"domain/source"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 // HandlerRegistration holds the metadata for a source handler registration.
@@ -18,6 +22,7 @@ type HandlerRegistration struct {
 }
 
 // setupGracefulShutdown handles termination signals.
+// The first signal cancels the context and lets in-flight work drain, a second one exits immediately.
 func setupGracefulShutdown(cancelFunc context.CancelFunc) {
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
@@ -25,39 +30,88 @@ func setupGracefulShutdown(cancelFunc context.CancelFunc) {
 		<-signalChan
 		log.Println("Received termination signal, shutting down gracefully...")
 		cancelFunc()
+
+		<-signalChan
+		log.Println("Received second termination signal, exiting immediately.")
+		os.Exit(1)
 	}()
 }
 
-// runProcessor initializes and runs the application processor.
-func runProcessor(ctx context.Context, c *application.Container) error {
-	// Get the processor service and source factory from the application container.
-	processor := c.ProcessorService.Get()
+// registerHandlers dynamically registers each source handler in the source factory.
+func registerHandlers(c *application.Container) error {
 	sourceFactory := c.SourceFactory.Get()
 
-	// Dynamically register each handler.
 	for _, handler := range getItemsToProcess(c) {
 		if err := sourceFactory.Register(handler.Name, handler.Handler); err != nil {
 			return fmt.Errorf("register %s error: %v", handler.Name, err)
 		}
 		log.Printf("Registered source handler: %s", handler.Name)
 	}
+	return nil
+}
+
+// purgeSnapshots removes raw page snapshots older than the configured retention.
+func purgeSnapshots(ctx context.Context, c *application.Container) {
+	store := c.InfrastructureContainer.Get().SnapshotStore.Get()
+	before := time.Now().Add(-c.Config.Get().Snapshot.Retention)
+
+	removed, err := store.Purge(ctx, before)
+	if err != nil {
+		log.Printf("Error purging snapshots: %v", err)
+		return
+	}
+	log.Printf("Purged %d expired snapshots.", removed)
+}
+
+// purgeSnapshotsDaily purges expired snapshots right away and then once a day until the context is cancelled.
+func purgeSnapshotsDaily(ctx context.Context, c *application.Container) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		purgeSnapshots(ctx, c)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runProcessor performs a single pass over all registered sources.
+func runProcessor(ctx context.Context, c *application.Container) {
+	processor := c.ProcessorService.Get()
+	purgeSnapshots(ctx, c)
 
 	// Start the processor.
 	log.Println("Starting the processor...")
 	processor.Run(ctx)
 	log.Println("Processor completed successfully.")
-	return nil
+}
+
+// runDaemon crawls every registered source on its own schedule until the context is cancelled.
+func runDaemon(ctx context.Context, c *application.Container) {
+	scheduler := c.CrawlerScheduler.Get()
+
+	log.Println("Starting the crawler in daemon mode...")
+	scheduler.Start(ctx)
+
+	// Purge expired snapshots until shutdown.
+	purgeSnapshotsDaily(ctx, c)
+
+	log.Println("Waiting for running crawls to finish...")
+	scheduler.Stop()
 }
 
 // getItemsToProcess defines the handlers to be registered and processed.
 func getItemsToProcess(c *application.Container) []HandlerRegistration {
 	return []HandlerRegistration{
 		{
-			Name:    "alfa",
+			Name:    alfa.Name,
 			Handler: c.AlfaHandler.Get(),
 		},
 		{
-			Name:    "beta",
+			Name:    beta.Name,
 			Handler: c.BetaHandler.Get(),
 		},
 	}
@@ -65,6 +119,9 @@ func getItemsToProcess(c *application.Container) []HandlerRegistration {
 
 // main is the entry point for the application.
 func main() {
+	daemon := flag.Bool("daemon", false, "Run continuously, crawling each source on its own schedule")
+	flag.Parse()
+
 	// Initialize application container.
 	c := application.NewContainer()
 
@@ -74,7 +131,14 @@ func main() {
 	// Setup graceful shutdown listener
 	setupGracefulShutdown(cancel)
 
-	if err := runProcessor(ctx, c); err != nil {
+	if err := registerHandlers(c); err != nil {
 		log.Printf("Error running processor: %v", err)
+		return
+	}
+
+	if *daemon {
+		runDaemon(ctx, c)
+		return
 	}
+	runProcessor(ctx, c)
 }
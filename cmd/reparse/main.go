@@ -0,0 +1,68 @@
+package main
+
+import (
+	"application"
+	"application/source/alfa"
+	"application/source/beta"
+	"application/vacancy/reparse"
+	"context"
+	"domain/html"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// getParsers defines the parsers available for re-parsing, keyed by source name.
+func getParsers(c *application.Container) map[string]html.Parser {
+	return map[string]html.Parser{
+		alfa.Name: c.AlfaHtmlParser.Get(),
+		beta.Name: c.BetaHtmlParser.Get(),
+	}
+}
+
+// getHosts defines the hosts the pages of each source are served from, keyed by source name.
+func getHosts(c *application.Container) map[string][]string {
+	cfg := c.Config.Get().SourceHandler
+	return map[string][]string{
+		alfa.Name: cfg.Alfa.Hosts(),
+		beta.Name: cfg.Beta.Hosts(),
+	}
+}
+
+// run re-parses the vacancies of the named source.
+func run(ctx context.Context, c *application.Container, source string, opts reparse.Options) error {
+	parser, ok := getParsers(c)[source]
+	if !ok {
+		return fmt.Errorf("unknown source %q", source)
+	}
+	opts.Hosts = getHosts(c)[source]
+
+	log.Printf("Re-parsing %s vacancies with parser %s version %s...", source, parser.Name(), parser.Version())
+	result, err := c.ReparseService.Get().Run(ctx, parser, opts)
+	log.Printf("Checked %d, changed %d, unchanged %d, missing snapshot %d, failed %d.",
+		result.Checked, result.Changed, result.Unchanged, result.Missing, result.Failed)
+	if err != nil {
+		return fmt.Errorf("re-parse: %w", err)
+	}
+	return nil
+}
+
+// main is the entry point for the re-parse command.
+func main() {
+	var (
+		source = flag.String("source", "", "Name of the source whose vacancies are re-parsed")
+		all    = flag.Bool("all", false, "Re-parse all vacancies, not only those produced by older parser versions")
+		dryRun = flag.Bool("dry-run", false, "Print the differences without updating any vacancy")
+	)
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx, application.NewContainer(), *source, reparse.Options{All: *all, DryRun: *dryRun})
+	cancel()
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
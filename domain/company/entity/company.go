@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Status tells whether the vacancies of a company are blocked or allowed regardless of the source rules.
+type Status string
+
+const (
+	StatusNone    Status = ""        // The vacancies of the company go through the source rules.
+	StatusAllowed Status = "allowed" // The vacancies of the company are kept even when the source rules drop them.
+	StatusBlocked Status = "blocked" // The vacancies of the company are dropped.
+)
+
+// Company represents an employer, identified by its normalised name, with the raw names it was seen under.
+type Company struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`          // Unique identifier for the company.
+	Key         string             `bson:"key" json:"key"`                   // The normalised name, e.g. "acme".
+	Name        string             `bson:"name" json:"name"`                 // The raw name it was first seen under.
+	Aliases     []string           `bson:"aliases" json:"aliases"`           // The raw names the company was seen under.
+	Status      Status             `bson:"status,omitempty" json:"status"`   // Whether vacancies are blocked or allowed.
+	FirstSeenAt time.Time          `bson:"first_seen_at" json:"firstSeenAt"` // When its first vacancy was seen.
+	LastSeenAt  time.Time          `bson:"last_seen_at" json:"lastSeenAt"`   // When the latest vacancy was seen.
+	Vacancies   int                `bson:"vacancies" json:"vacancies"`       // Number of vacancies stored.
+}
+
+// Blocked reports whether the vacancies of the company are dropped.
+func (c *Company) Blocked() bool {
+	return c != nil && c.Status == StatusBlocked
+}
+
+// Allowed reports whether the vacancies of the company are kept regardless of the source rules.
+func (c *Company) Allowed() bool {
+	return c != nil && c.Status == StatusAllowed
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"domain/company/entity"
+	"time"
+)
+
+// CompanyRepository defines the interface for interacting with companies in the persistence layer.
+type CompanyRepository interface {
+	// Upsert records that the company with the given key was seen under name at seenAt, creating the company
+	// if it does not exist yet, and returns the updated company. Recording the same sighting again has no effect.
+	// Returns an error if the operation fails.
+	Upsert(ctx context.Context, key, name string, seenAt time.Time) (*entity.Company, error)
+
+	// IncrementVacancies adds a newly stored vacancy to the count of the company with the given key.
+	// Returns an error if the operation fails.
+	IncrementVacancies(ctx context.Context, key string) error
+
+	// SetStatus sets the status of the company with the given key, creating the company under name if it does
+	// not exist yet, and returns the updated company.
+	// Returns an error if the operation fails.
+	SetStatus(ctx context.Context, key, name string, status entity.Status) (*entity.Company, error)
+
+	// FetchByKey retrieves the company with the given key.
+	// Returns nil if there is no such company, or an error if the operation fails.
+	FetchByKey(ctx context.Context, key string) (*entity.Company, error)
+}
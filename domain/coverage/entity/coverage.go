@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Coverage represents the field coverage of a single batch of parsed pages of a source.
+// Coverage of a field is the share of pages in the batch it was extracted from with a non-placeholder value.
+type Coverage struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`                   // Unique identifier (ObjectID).
+	Source        string             `bson:"source" json:"source"`                      // Name of the source.
+	Parser        string             `bson:"parser" json:"parser"`                      // Name of the parser.
+	ParserVersion string             `bson:"parser_version" json:"parserVersion"`       // Parser version.
+	CreatedAt     time.Time          `bson:"created_at" json:"createdAt"`               // Batch timestamp.
+	Samples       int                `bson:"samples" json:"samples"`                    // Pages parsed.
+	Sparse        bool               `bson:"sparse,omitempty" json:"sparse"`            // Too few pages to compare.
+	Fields        map[string]float64 `bson:"fields" json:"fields"`                      // Coverage per field (0-1).
+	Baseline      map[string]float64 `bson:"baseline,omitempty" json:"baseline"`        // Baseline per field.
+	Drift         []string           `bson:"drift,omitempty" json:"drift"`              // Fields that dropped sharply.
+	PausedUntil   time.Time          `bson:"paused_until,omitempty" json:"pausedUntil"` // End of the pause.
+}
+
+// Drifted reports whether the coverage of any field dropped sharply compared to the baseline.
+func (c *Coverage) Drifted() bool {
+	return len(c.Drift) > 0
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"domain/coverage/entity"
+	"time"
+)
+
+// CoverageRepository defines the interface for storing the field coverage of parsed batches.
+type CoverageRepository interface {
+	// Save persists the coverage of a batch into the data source.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, coverage *entity.Coverage) error
+
+	// FetchBaseline retrieves the most recent batches of the source that did not drift and were not sparse,
+	// newest first.
+	// Returns an error if the operation fails.
+	FetchBaseline(ctx context.Context, source string, limit int) ([]*entity.Coverage, error)
+
+	// FetchPause retrieves the most recent batch of the source that pauses it beyond now.
+	// Returns nil if the source is not paused, or an error if the operation fails.
+	FetchPause(ctx context.Context, source string, now time.Time) (*entity.Coverage, error)
+}
@@ -1,10 +1,24 @@
 package html
 
-import "context"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 // Fetcher defines the contract for fetching HTML content.
 type Fetcher interface {
 	// Fetch retrieves the raw HTML content from the specified URL.
-	// Returns the raw HTML content, or an error if the fetching process fails.
-	Fetch(ctx context.Context, url string) (body string, err error)
+	// Returns the response, or an error if the fetching process fails. The response is also returned
+	// together with the error when the server answered but the answer was rejected (e.g., a block page).
+	Fetch(ctx context.Context, url string) (response *Response, err error)
+}
+
+// Response holds a fetched page together with the metadata of the response.
+type Response struct {
+	Body       string      // Body is the raw content of the page.
+	FinalURL   string      // FinalURL is the URL the page was served from after redirects.
+	StatusCode int         // StatusCode is the HTTP status code of the response.
+	Header     http.Header // Header holds the response headers.
+	FetchedAt  time.Time   // FetchedAt is the time the response was received.
 }
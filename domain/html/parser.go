@@ -1,10 +1,23 @@
 package html
 
-import "application/url/processor/dto"
+import (
+	"application/url/processor/dto"
+	"time"
+)
 
 // Parser defines the interface for parsing raw HTML content into structured data.
 type Parser interface {
 	// Parse processes the raw HTML content and converts it into a Vacancy DTO.
 	// Returns a DTO populated with the parsed data, or an error if the parsing process fails.
+	// Relative dates are resolved against the current time.
 	Parse(html string) (*dto.Vacancy, error)
+
+	// ParseAt works like Parse, resolving relative dates such as "3 days ago" against the time the page was fetched.
+	ParseAt(html string, fetchedAt time.Time) (*dto.Vacancy, error)
+
+	// Name returns the name identifying the parser.
+	Name() string
+
+	// Version returns the version of the extraction rules, changed whenever the parser output changes.
+	Version() string
 }
@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reason classifies why processing a URL failed.
+type Reason string
+
+const (
+	ReasonNetwork    Reason = "network"     // The request could not be completed (connection, proxy, timeout).
+	ReasonHTTPStatus Reason = "http_status" // The server answered with an unexpected HTTP status.
+	ReasonBlocked    Reason = "blocked"     // The server refused the crawler (ban, rate limit, captcha).
+	ReasonClosed     Reason = "closed"      // The posting was removed or closed by the board.
+	ReasonParse      Reason = "parse"       // The page could not be parsed, usually after a layout change.
+	ReasonValidation Reason = "validation"  // The parsed data is incomplete or invalid.
+	ReasonStorage    Reason = "storage"     // The result could not be stored.
+)
+
+// Error is a pipeline failure classified by its reason.
+type Error struct {
+	Reason     Reason // Reason classifies the failure.
+	HTTPStatus int    // HTTPStatus is the status code of the response, zero when there was none.
+	Err        error  // Err is the underlying error.
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	if e.HTTPStatus != 0 {
+		return fmt.Sprintf("%s (http %d): %v", e.Reason, e.HTTPStatus, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", e.Reason, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Network wraps err as a network failure.
+func Network(err error) error {
+	return &Error{Reason: ReasonNetwork, Err: err}
+}
+
+// HTTPStatus returns an error for a response with an unexpected status code.
+func HTTPStatus(status int) error {
+	return &Error{Reason: ReasonHTTPStatus, HTTPStatus: status, Err: errors.New("unexpected status code")}
+}
+
+// Blocked wraps err as a refusal by the server, status is the code of the response that revealed it.
+func Blocked(status int, err error) error {
+	return &Error{Reason: ReasonBlocked, HTTPStatus: status, Err: err}
+}
+
+// Closed wraps err as a posting the board removed or closed, status is the code of the response that revealed it.
+func Closed(status int, err error) error {
+	return &Error{Reason: ReasonClosed, HTTPStatus: status, Err: err}
+}
+
+// Parse wraps err as a parse failure.
+func Parse(err error) error {
+	return &Error{Reason: ReasonParse, Err: err}
+}
+
+// Validation wraps err as a validation failure.
+func Validation(err error) error {
+	return &Error{Reason: ReasonValidation, Err: err}
+}
+
+// Storage wraps err as a storage failure.
+func Storage(err error) error {
+	return &Error{Reason: ReasonStorage, Err: err}
+}
+
+// Classify returns the reason and HTTP status of err.
+// Errors that are not classified are reported with the fallback reason.
+func Classify(err error, fallback Reason) (Reason, int) {
+	var pErr *Error
+	if errors.As(err, &pErr) {
+		return pErr.Reason, pErr.HTTPStatus
+	}
+	return fallback, 0
+}
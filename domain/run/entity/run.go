@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Run represents a crawl run report.
+// It captures when the run took place and what happened to each of the processed sources.
+type Run struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`       // Unique identifier for the run (MongoDB ObjectID).
+	StartedAt  time.Time          `bson:"started_at" json:"startedAt"`   // The timestamp when the run started.
+	FinishedAt time.Time          `bson:"finished_at" json:"finishedAt"` // The timestamp when the run finished.
+	Sources    []SourceStats      `bson:"sources" json:"sources"`        // Statistics of every source processed by the run.
+}
+
+// SourceStats holds the counters of a single source within a run.
+type SourceStats struct {
+	Name            string         `bson:"name" json:"name"`                        // Name of the source.
+	StartedAt       time.Time      `bson:"started_at" json:"startedAt"`             // The timestamp when the source started.
+	FinishedAt      time.Time      `bson:"finished_at" json:"finishedAt"`           // The timestamp when the source finished.
+	Discovered      int            `bson:"discovered" json:"discovered"`            // Number of URLs found in the sitemap.
+	New             int            `bson:"new" json:"new"`                          // Number of discovered URLs not seen before.
+	Fetched         int            `bson:"fetched" json:"fetched"`                  // Number of pages downloaded.
+	Parsed          int            `bson:"parsed" json:"parsed"`                    // Number of pages parsed into vacancies.
+	Saved           int            `bson:"saved" json:"saved"`                      // Number of new vacancies saved.
+	Updated         int            `bson:"updated" json:"updated"`                  // Number of vacancies changed since last crawl.
+	Unchanged       int            `bson:"unchanged" json:"unchanged"`              // Number of vacancies re-crawled unchanged.
+	Dropped         int            `bson:"dropped" json:"dropped"`                  // Number of vacancies dropped by rules.
+	Closed          int            `bson:"closed" json:"closed"`                    // Number of postings found closed.
+	Delisted        int            `bson:"delisted" json:"delisted"`                // Number of URLs gone from the sitemap.
+	Failed          map[string]int `bson:"failed" json:"failed"`                    // Number of failed URLs by reason.
+	CircuitsRotated int            `bson:"circuits_rotated" json:"circuitsRotated"` // Number of proxy circuits changed.
+	BytesDownloaded int64          `bson:"bytes_downloaded" json:"bytesDownloaded"` // Number of bytes downloaded.
+	Errors          []string       `bson:"errors" json:"errors"`                    // Errors encountered, capped in size.
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"domain/run/entity"
+)
+
+// RunRepository defines the interface for interacting with crawl run reports in the persistence layer.
+type RunRepository interface {
+	// Save persists a new run report into the data source.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, run *entity.Run) error
+
+	// FetchLatest retrieves the most recent run reports, newest first.
+	// Returns an error if the operation fails.
+	FetchLatest(ctx context.Context, limit int) ([]*entity.Run, error)
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"net/http"
+	"time"
+)
+
+// Snapshot represents the raw response of a fetched page.
+// It is stored so that pages can be parsed again without fetching them a second time.
+type Snapshot struct {
+	ID         string      `json:"id"`         // Content address of the snapshot (hex encoded SHA-256 of URL and body).
+	URL        string      `json:"url"`        // The URL that was requested.
+	FinalURL   string      `json:"finalUrl"`   // The URL the page was served from after redirects.
+	StatusCode int         `json:"statusCode"` // The HTTP status code of the response.
+	Header     http.Header `json:"header"`     // The response headers.
+	FetchedAt  time.Time   `json:"fetchedAt"`  // The timestamp when the response was received.
+	Body       string      `json:"body"`       // The raw content of the page.
+}
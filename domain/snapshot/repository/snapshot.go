@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"domain/snapshot/entity"
+	"time"
+)
+
+// SnapshotRepository defines the interface for storing raw page snapshots.
+type SnapshotRepository interface {
+	// Save persists the snapshot and sets its ID to the SHA-256 hash of its URL, a zero byte and its body.
+	// Saving the same body of the same URL again refreshes it instead of storing a copy.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, snapshot *entity.Snapshot) error
+
+	// FindByID retrieves a snapshot by its ID.
+	// Returns an error if the operation fails.
+	FindByID(ctx context.Context, id string) (*entity.Snapshot, error)
+
+	// Purge removes snapshots stored before the given time, along with the leftovers of interrupted saves.
+	// Returns the number of removed snapshots, or an error if the operation fails.
+	Purge(ctx context.Context, before time.Time) (int, error)
+}
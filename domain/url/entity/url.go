@@ -8,9 +8,22 @@ import (
 
 // Url represents a URL entity.
 // It captures information about the URL to be processed, its status, and metadata.
+//
+//nolint:lll // The struct tags are long, the comments are kept trailing and aligned.
 type Url struct {
 	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`    // Unique identifier for the URL (MongoDB ObjectID).
 	Address   string             `bson:"address" json:"address"`     // The URL address to be processed.
+	Source    string             `bson:"source" json:"source"`       // Name of the source the URL belongs to.
 	Status    string             `bson:"status" json:"status"`       // Current processing status of the URL.
 	Processed time.Time          `bson:"processed" json:"processed"` // Timestamp of when the URL was processed.
+
+	// Failure of the latest fetch.
+	FailureReason string `bson:"failure_reason,omitempty" json:"failureReason,omitempty"` // Why it failed, e.g. "network".
+	HTTPStatus    int    `bson:"http_status,omitempty" json:"httpStatus,omitempty"`       // Status code of the failed response.
+
+	// Sitemap state.
+	PublishedAt time.Time `bson:"published_at,omitempty" json:"publishedAt,omitempty"` // Publication date announced by the feed.
+	SnapshotID  string    `bson:"snapshot_id,omitempty" json:"snapshotId,omitempty"`   // Stored raw response of the latest fetch.
+	SeenAt      time.Time `bson:"seen_at,omitempty" json:"seenAt,omitempty"`           // Latest run that found it in the sitemap.
+	Misses      int       `bson:"misses,omitempty" json:"misses,omitempty"`            // Consecutive runs that did not find it.
 }
@@ -12,11 +12,38 @@ type UrlRepository interface {
 	// Returns an error if the operation fails.
 	Save(ctx context.Context, url *entity.Url) error
 
-	// FetchBatch retrieves a batch of URLs with the specified status.
+	// SaveIfNew persists the URL entity unless a URL with the same address already exists.
+	// Returns true if the URL was inserted, or an error if the operation fails.
+	SaveIfNew(ctx context.Context, url *entity.Url) (bool, error)
+
+	// FetchBatch retrieves a batch of URLs of the given source with the specified status.
 	// Returns a slice of URL entities matching the criteria.
-	FetchBatch(ctx context.Context, status string, limit int) ([]*entity.Url, error)
+	FetchBatch(ctx context.Context, source, status string, limit int) ([]*entity.Url, error)
 
 	// UpdateStatus updates the status of URL entity in the data source.
 	// Returns an error if the operation fails.
 	UpdateStatus(ctx context.Context, id, status string, processedTime *time.Time) error
+
+	// MarkFailed sets the status of URL entity to "failed" and records why processing failed.
+	// Returns an error if the operation fails.
+	MarkFailed(ctx context.Context, id, reason string, httpStatus int, processedTime *time.Time) error
+
+	// MarkSeen records that a run of the source found the given addresses in its sitemap at seenAt.
+	// Their misses are reset, and delisted ones are made pending again, so that they are fetched anew.
+	// Returns an error if the operation fails.
+	MarkSeen(ctx context.Context, source string, addresses []string, seenAt time.Time) error
+
+	// MarkMissing counts a miss for every URL of the source the run at seenAt did not find in the sitemap,
+	// and sets the status of the URLs missing for maxMisses consecutive runs to "delisted".
+	// Returns the URLs delisted by this call, or an error if the operation fails.
+	MarkMissing(ctx context.Context, source string, seenAt time.Time, maxMisses int) ([]*entity.Url, error)
+
+	// Requeue sets the status of the successful and failed URLs of the source processed before the given time
+	// back to "pending", so that they are fetched again.
+	// Returns the number of requeued URLs, or an error if the operation fails.
+	Requeue(ctx context.Context, source string, processedBefore time.Time) (int, error)
+
+	// SetSnapshot links the URL entity to the stored snapshot of its latest fetch.
+	// Returns an error if the operation fails.
+	SetSnapshot(ctx context.Context, id, snapshotID string) error
 }
@@ -0,0 +1,32 @@
+package entity
+
+// Seniority is the experience level a vacancy is aimed at.
+type Seniority string
+
+const (
+	SeniorityIntern    Seniority = "intern"    // SeniorityIntern is an internship or traineeship.
+	SeniorityJunior    Seniority = "junior"    // SeniorityJunior is an entry level position.
+	SeniorityMid       Seniority = "mid"       // SeniorityMid is a regular position.
+	SenioritySenior    Seniority = "senior"    // SenioritySenior is a senior position.
+	SeniorityLead      Seniority = "lead"      // SeniorityLead is a team or tech lead position.
+	SeniorityPrincipal Seniority = "principal" // SeniorityPrincipal is a staff, principal or architect position.
+)
+
+// EmploymentType is the kind of contract a vacancy is offered under.
+type EmploymentType string
+
+const (
+	EmploymentPermanent EmploymentType = "permanent" // EmploymentPermanent is a full-time employment contract.
+	EmploymentB2B       EmploymentType = "b2b"       // EmploymentB2B is a business-to-business contract.
+	EmploymentContract  EmploymentType = "contract"  // EmploymentContract is a civil law, fixed-term or freelance one.
+	EmploymentPartTime  EmploymentType = "part-time" // EmploymentPartTime is a part-time position.
+)
+
+// WorkMode is where the work of a vacancy is done.
+type WorkMode string
+
+const (
+	WorkModeRemote WorkMode = "remote" // WorkModeRemote is fully remote work.
+	WorkModeHybrid WorkMode = "hybrid" // WorkModeHybrid splits the work between home and office.
+	WorkModeOnsite WorkMode = "onsite" // WorkModeOnsite is work from the office.
+)
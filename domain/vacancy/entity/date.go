@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// DateConfidence describes how reliably the posting date of a vacancy was extracted.
+type DateConfidence string
+
+const (
+	// DateConfidenceHigh is used for dates taken from structured data such as JSON-LD, meta tags or the feed.
+	DateConfidenceHigh DateConfidence = "high"
+	// DateConfidenceMedium is used for absolute dates parsed from the page text.
+	DateConfidenceMedium DateConfidence = "medium"
+	// DateConfidenceLow is used for relative phrases such as "3 days ago" resolved against the fetch time.
+	DateConfidenceLow DateConfidence = "low"
+	// DateConfidenceNone is used when no date was found and the fetch time is used instead.
+	DateConfidenceNone DateConfidence = "none"
+)
+
+// Rank orders confidences from zero, for unknown values, to the highest confidence.
+func (c DateConfidence) Rank() int {
+	switch c {
+	case DateConfidenceHigh:
+		return 4
+	case DateConfidenceMedium:
+		return 3
+	case DateConfidenceLow:
+		return 2
+	case DateConfidenceNone:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SetPostedAt records the posting date unless the current one was extracted with a higher confidence.
+func (v *Vacancy) SetPostedAt(postedAt time.Time, confidence DateConfidence) {
+	if postedAt.IsZero() || confidence.Rank() < v.PostedAtConfidence.Rank() {
+		return
+	}
+	v.PostedAt, v.PostedAtConfidence = postedAt, confidence
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hash returns the hash of the content the board published for the vacancy: title, company, description,
+// location and salary. Fields derived by the bot and the posting date, which boards bump on refreshed listings,
+// are left out, so that only a real change of the posting changes the hash.
+func (v *Vacancy) Hash() string {
+	h := sha256.New()
+	for _, field := range []string{v.Title, v.Company, v.Description, v.Location, v.Salary.String()} {
+		_, _ = h.Write([]byte(field))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,42 @@
+package entity
+
+import "strings"
+
+// Place is a normalised location of a vacancy: a city, a country, or remote work, optionally restricted to a
+// country or region.
+type Place struct {
+	City    string    `bson:"city,omitempty" json:"city,omitempty"`       // The canonical city name.
+	Country string    `bson:"country,omitempty" json:"country,omitempty"` // The ISO 3166-1 alpha-2 country code.
+	Region  string    `bson:"region,omitempty" json:"region,omitempty"`   // A region qualifier such as "EU" or "DACH".
+	Remote  bool      `bson:"remote,omitempty" json:"remote,omitempty"`   // Whether the place stands for remote work.
+	Point   *GeoPoint `bson:"point,omitempty" json:"point,omitempty"`     // The city coordinates, nil when unknown.
+}
+
+// GeoPoint is a GeoJSON point, as indexed by MongoDB 2dsphere indexes.
+type GeoPoint struct {
+	Type        string    `bson:"type" json:"type"`               // The GeoJSON type, always "Point".
+	Coordinates []float64 `bson:"coordinates" json:"coordinates"` // The longitude and latitude, in that order.
+}
+
+// NewGeoPoint creates and returns a new GeoPoint at the given latitude and longitude.
+func NewGeoPoint(latitude, longitude float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{longitude, latitude}}
+}
+
+// String formats the place, e.g. "Warsaw, PL" or "Remote (EU)".
+func (p Place) String() string {
+	var parts []string
+	for _, part := range []string{p.City, p.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if p.Remote {
+		parts = append([]string{"Remote"}, parts...)
+	}
+	s := strings.Join(parts, ", ")
+	if p.Region != "" {
+		s = strings.TrimSpace(s + " (" + p.Region + ")")
+	}
+	return s
+}
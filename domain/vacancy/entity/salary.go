@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SalaryPeriod is the period a salary amount is paid for.
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHour  SalaryPeriod = "hour"  // SalaryPeriodHour is an hourly rate.
+	SalaryPeriodDay   SalaryPeriod = "day"   // SalaryPeriodDay is a daily rate.
+	SalaryPeriodWeek  SalaryPeriod = "week"  // SalaryPeriodWeek is a weekly salary.
+	SalaryPeriodMonth SalaryPeriod = "month" // SalaryPeriodMonth is a monthly salary.
+	SalaryPeriodYear  SalaryPeriod = "year"  // SalaryPeriodYear is a yearly salary.
+)
+
+// SalaryBasis tells whether a salary amount is before or after taxes.
+type SalaryBasis string
+
+const (
+	SalaryBasisGross SalaryBasis = "gross" // SalaryBasisGross is an amount before taxes.
+	SalaryBasisNet   SalaryBasis = "net"   // SalaryBasisNet is an amount after taxes.
+)
+
+// SalaryContract is the kind of contract a salary is offered under.
+type SalaryContract string
+
+const (
+	SalaryContractPermanent SalaryContract = "permanent" // SalaryContractPermanent is an employment contract.
+	SalaryContractB2B       SalaryContract = "b2b"       // SalaryContractB2B is a business-to-business contract.
+	SalaryContractContract  SalaryContract = "contract"  // SalaryContractContract is a civil law or freelance contract.
+)
+
+// Salary represents the compensation offered by a vacancy.
+// Min and Max are the amounts as announced, AnnualMin and AnnualMax the same range per year in the reference
+// currency, left empty when no exchange rate is known for the currency.
+type Salary struct {
+	Min            float64        `bson:"min" json:"min"`                                            // Lower bound.
+	Max            float64        `bson:"max" json:"max"`                                            // Upper bound.
+	Currency       string         `bson:"currency" json:"currency"`                                  // ISO 4217 code.
+	Period         SalaryPeriod   `bson:"period" json:"period"`                                      // Pay period.
+	Basis          SalaryBasis    `bson:"basis,omitempty" json:"basis,omitempty"`                    // Gross or net.
+	Contract       SalaryContract `bson:"contract,omitempty" json:"contract,omitempty"`              // Contract type.
+	AnnualMin      float64        `bson:"annual_min,omitempty" json:"annualMin,omitempty"`           // Annual minimum.
+	AnnualMax      float64        `bson:"annual_max,omitempty" json:"annualMax,omitempty"`           // Annual maximum.
+	AnnualCurrency string         `bson:"annual_currency,omitempty" json:"annualCurrency,omitempty"` // Annual currency.
+}
+
+// String formats the salary as announced, e.g. "10000-15000 PLN/month net b2b", nil formats as an empty string.
+func (s *Salary) String() string {
+	if s == nil {
+		return ""
+	}
+
+	amount := strconv.FormatFloat(s.Min, 'f', -1, 64)
+	if s.Max != s.Min {
+		amount += "-" + strconv.FormatFloat(s.Max, 'f', -1, 64)
+	}
+	parts := []string{fmt.Sprintf("%s %s/%s", amount, s.Currency, s.Period)}
+	for _, part := range []string{string(s.Basis), string(s.Contract)} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -8,6 +8,8 @@ import (
 
 // Vacancy represents a job vacancy entity.
 // It captures details such as the title, company, description, posting date, and location.
+//
+//nolint:lll // The struct tags are long, the comments are kept trailing and aligned.
 type Vacancy struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`        // Unique identifier for the URL
 	Title       string             `bson:"title" json:"title"`             // The title of the job vacancy.
@@ -16,4 +18,51 @@ type Vacancy struct {
 	PostedAt    time.Time          `bson:"posted_at" json:"postedAt"`      // The date and time when it was posted.
 	Location    string             `bson:"location" json:"location"`       // The location of the job vacancy.
 	SentAt      time.Time          `bson:"sent_at" json:"sentAt"`          // The timestamp when the vacancy was sent.
+	Quarantined bool               `bson:"quarantined" json:"quarantined"` // Whether the vacancy failed validation.
+
+	// Extraction quality.
+	PostedAtConfidence DateConfidence `bson:"posted_at_confidence,omitempty" json:"postedAtConfidence,omitempty"` // How reliably PostedAt was extracted.
+	DescriptionHTML    string         `bson:"description_html,omitempty" json:"descriptionHtml,omitempty"`        // Sanitised HTML of the description.
+	QuarantineReason   string         `bson:"quarantine_reason,omitempty" json:"quarantineReason,omitempty"`      // Why validation failed.
+
+	// Provenance.
+	SourceName    string `bson:"source_name,omitempty" json:"sourceName,omitempty"`       // Name of the source, e.g. "alfa".
+	SourceURL     string `bson:"source_url,omitempty" json:"sourceUrl,omitempty"`         // Page the vacancy was parsed from, the apply link.
+	ExternalID    string `bson:"external_id,omitempty" json:"externalId,omitempty"`       // Identifier assigned by the board.
+	SnapshotID    string `bson:"snapshot_id,omitempty" json:"snapshotId,omitempty"`       // Stored raw page it was parsed from.
+	Parser        string `bson:"parser,omitempty" json:"parser,omitempty"`                // Name of the parser that produced it.
+	ParserVersion string `bson:"parser_version,omitempty" json:"parserVersion,omitempty"` // Version of that parser.
+
+	// Re-crawl and delivery state.
+	ContentHash string    `bson:"content_hash,omitempty" json:"contentHash,omitempty"` // Hash of the published content.
+	UpdatedAt   time.Time `bson:"updated_at,omitempty" json:"updatedAt,omitempty"`     // Last time it was stored or crawled.
+	RemoteID    int64     `bson:"remote_id,omitempty" json:"remoteId,omitempty"`       // Identifier on the remote service, 0 if unsent.
+	ClosedAt    time.Time `bson:"closed_at,omitempty" json:"closedAt,omitempty"`       // When the board closed it, zero while open.
+
+	// Enrichment.
+	Salary             *Salary        `bson:"salary,omitempty" json:"salary,omitempty"`                          // Compensation offered, nil if not announced.
+	Skills             []string       `bson:"skills,omitempty" json:"skills,omitempty"`                          // Normalised skill tags, e.g. "go".
+	Seniority          Seniority      `bson:"seniority,omitempty" json:"seniority,omitempty"`                    // Experience level, empty if unknown.
+	EmploymentType     EmploymentType `bson:"employment_type,omitempty" json:"employmentType,omitempty"`         // Kind of contract.
+	WorkMode           WorkMode       `bson:"work_mode,omitempty" json:"workMode,omitempty"`                     // Remote, hybrid or onsite.
+	Places             []Place        `bson:"places,omitempty" json:"places,omitempty"`                          // Normalised locations parsed from Location.
+	CompanyID          string         `bson:"company_id,omitempty" json:"companyId,omitempty"`                   // Company shared by all spellings.
+	Language           string         `bson:"language,omitempty" json:"language,omitempty"`                      // ISO 639-1 code of the language.
+	LanguageConfidence float64        `bson:"language_confidence,omitempty" json:"languageConfidence,omitempty"` // Detection confidence, from 0 to 1.
+
+	// Deduplication.
+	Fingerprint string `bson:"fingerprint,omitempty" json:"fingerprint,omitempty"` // Key of title, company and location.
+	SimHash     int64  `bson:"simhash,omitempty" json:"simhash,omitempty"`         // SimHash of the description.
+	ClusterID   string `bson:"cluster_id,omitempty" json:"clusterId,omitempty"`    // Canonical vacancy of its near-duplicates.
+}
+
+// Canonical reports whether the vacancy is the canonical record of its cluster of near-duplicates.
+// Vacancies stored before deduplication are their own cluster.
+func (v *Vacancy) Canonical() bool {
+	return v.ClusterID == "" || v.ClusterID == v.ID.Hex()
+}
+
+// Closed reports whether the board closed the vacancy.
+func (v *Vacancy) Closed() bool {
+	return !v.ClosedAt.IsZero()
 }
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MinDescriptionLength is the minimum number of characters of a valid vacancy description.
+const MinDescriptionLength = 50
+
+// placeholders are lowercase values parsers fall back to when a field could not be extracted.
+var placeholders = map[string]struct{}{
+	"-":                {},
+	"n/a":              {},
+	"na":               {},
+	"none":             {},
+	"null":             {},
+	"unknown":          {},
+	"unknown title":    {},
+	"unknown company":  {},
+	"unknown location": {},
+}
+
+// ValidationError describes why a vacancy is not valid.
+type ValidationError struct {
+	Problems []string // Problems lists every rule the vacancy violates.
+}
+
+// Error returns the error message.
+func (e *ValidationError) Error() string {
+	return "invalid vacancy: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks that the vacancy has every required field filled with real data.
+// The location is optional, as many postings do not announce one.
+// Returns a *ValidationError listing all problems, or nil if the vacancy is valid.
+func (v *Vacancy) Validate() error {
+	var problems []string
+
+	required := []struct {
+		name  string
+		value string
+	}{
+		{name: "title", value: v.Title},
+		{name: "company", value: v.Company},
+		{name: "description", value: v.Description},
+	}
+	for _, field := range required {
+		switch {
+		case strings.TrimSpace(field.value) == "":
+			problems = append(problems, fmt.Sprintf("%s is missing", field.name))
+		case IsPlaceholder(field.value):
+			problems = append(problems, fmt.Sprintf("%s is a placeholder (%q)", field.name, field.value))
+		}
+	}
+
+	if length := utf8.RuneCountInString(strings.TrimSpace(v.Description)); length > 0 && length < MinDescriptionLength &&
+		!IsPlaceholder(v.Description) {
+		problems = append(problems, fmt.Sprintf("description is too short (%d < %d)", length, MinDescriptionLength))
+	}
+	if v.PostedAt.IsZero() {
+		problems = append(problems, "posted date is missing")
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// IsPlaceholder reports whether value is a known placeholder rather than extracted data.
+func IsPlaceholder(value string) bool {
+	_, ok := placeholders[strings.ToLower(strings.TrimSpace(value))]
+	return ok
+}
+
+// Quarantine marks the vacancy as invalid, so it is stored for inspection but never sent.
+func (v *Vacancy) Quarantine(reason string) {
+	v.Quarantined = true
+	v.QuarantineReason = reason
+}
@@ -19,10 +19,46 @@ type VacancyRepository interface {
 	// Returns an error if the operation fails.
 	Fetch(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*entity.Vacancy, error)
 
-	// FetchBatch retrieves a batch of vacancies where the SentAt field is not set.
+	// FetchBatch retrieves a batch of vacancies where the SentAt field is not set, excluding quarantined and closed ones.
 	// Returns a slice of Vacancy entities matching the criteria.
 	FetchBatch(ctx context.Context, limit int) ([]*entity.Vacancy, error)
 
+	// FetchOutdated retrieves vacancies produced by the named parser with a version other than the given one,
+	// ordered by ID and starting after afterID (empty for the first batch). An empty version matches all versions.
+	// Vacancies stored before their parser was recorded are matched by their source name, which equals the name
+	// of the parser, or by the host of their source URL being one of hosts.
+	// Returns an error if the operation fails.
+	FetchOutdated(
+		ctx context.Context,
+		parser, version string,
+		hosts []string,
+		afterID string,
+		limit int,
+	) ([]*entity.Vacancy, error)
+
+	// FetchByFingerprint retrieves up to limit vacancies with the given fingerprint, excluding quarantined ones,
+	// newest first.
+	// Returns an error if the operation fails.
+	FetchByFingerprint(ctx context.Context, fingerprint string, limit int) ([]*entity.Vacancy, error)
+
+	// FetchClosed retrieves up to limit closed vacancies that are still published on the remote service.
+	// Returns an error if the operation fails.
+	FetchClosed(ctx context.Context, limit int) ([]*entity.Vacancy, error)
+
+	// ClusterSent reports whether an open vacancy of the given cluster was already sent.
+	// Returns an error if the operation fails.
+	ClusterSent(ctx context.Context, clusterID string) (bool, error)
+
+	// ReleaseCluster flags the open vacancies of the given cluster that were skipped as duplicates, and so never
+	// published on the remote service, to be sent again.
+	// Returns the number of released vacancies, or an error if the operation fails.
+	ReleaseCluster(ctx context.Context, clusterID string) (int, error)
+
+	// FindBySource retrieves the vacancy of the named source with the given external ID, or with the given
+	// source URL when the external ID is empty.
+	// Returns nil if there is no such vacancy, or an error if the operation fails.
+	FindBySource(ctx context.Context, source, externalID, sourceURL string) (*entity.Vacancy, error)
+
 	// FindByID retrieves a vacancy by its ID.
 	// Returns an error if the operation fails.
 	FindByID(ctx context.Context, id string) (*entity.Vacancy, error)
@@ -0,0 +1,37 @@
+package classify
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/keyword"
+	"strings"
+)
+
+// employmentTypes lists the words announcing the kind of contract.
+var employmentTypes = []keyword.Keyword[entity.EmploymentType]{
+	{Words: []string{"part-time", "part time", "teilzeit", "pół etatu", "niepełny etat", "half-time", "minijob",
+		"частичная занятость", "часткова зайнятість"}, Value: entity.EmploymentPartTime},
+	{Words: []string{"b2b"}, Value: entity.EmploymentB2B},
+	{Words: []string{"contractor", "contract", "freelance", "umowa zlecenie", "umowa o dzieło", "fixed-term",
+		"befristet"}, Value: entity.EmploymentContract},
+	{Words: []string{"permanent", "full-time", "full time", "vollzeit", "festanstellung", "unbefristet",
+		"umowa o pracę", "umowa o prace", "uop", "employment contract", "pełny etat", "pełen etat", "cdi"},
+		Value: entity.EmploymentPermanent},
+}
+
+// contractTypes maps the contract announced with a salary to the employment type.
+var contractTypes = map[entity.SalaryContract]entity.EmploymentType{
+	entity.SalaryContractPermanent: entity.EmploymentPermanent,
+	entity.SalaryContractB2B:       entity.EmploymentB2B,
+	entity.SalaryContractContract:  entity.EmploymentContract,
+}
+
+// EmploymentType derives the kind of contract from the contract announced with the salary or, failing that,
+// from the first contract the title or description mentions. Returns an empty type when none is announced.
+func EmploymentType(salary *entity.Salary, title, description string) entity.EmploymentType {
+	if salary != nil {
+		if employment, ok := contractTypes[salary.Contract]; ok {
+			return employment
+		}
+	}
+	return keyword.Find(strings.ToLower(title+"\n"+description), employmentTypes, "")
+}
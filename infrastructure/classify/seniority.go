@@ -0,0 +1,75 @@
+package classify
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/keyword"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// seniorities lists the title words announcing the experience level.
+var seniorities = []keyword.Keyword[entity.Seniority]{
+	{Words: []string{"intern", "interns", "internship", "trainee", "praktikant", "praktikantin", "praktyki", "staż",
+		"stażysta", "stażystka", "werkstudent", "working student", "apprentice", "стажер", "стажёр", "стажист"},
+		Value: entity.SeniorityIntern, Exact: true},
+	{Words: []string{"junior", "jr", "entry level", "entry-level", "graduate", "młodszy", "младший", "молодший"},
+		Value: entity.SeniorityJunior, Exact: true},
+	{Words: []string{"mid", "mid-level", "mid level", "middle", "regular", "intermediate", "medior"},
+		Value: entity.SeniorityMid, Exact: true},
+	{Words: []string{"senior", "sr", "starszy", "старший", "experienced"},
+		Value: entity.SenioritySenior, Exact: true},
+	{Words: []string{"lead", "team lead", "tech lead", "teamlead", "techlead", "head of", "lider", "leiter"},
+		Value: entity.SeniorityLead, Exact: true},
+	{Words: []string{"principal", "staff", "architect", "distinguished"},
+		Value: entity.SeniorityPrincipal, Exact: true},
+}
+
+// experienceWords announce that a number of years is the required experience.
+var experienceWords = []string{"experience", "doświadczeni", "erfahrung", "expérience", "experiencia", "esperienza",
+	"опыт", "досвід"}
+
+// years matches a number of years such as "5+ years", "3-5 lat" or "2 Jahre".
+var years = regexp.MustCompile(`(?i)(\d{1,2})\s*\+?\s*(?:-\s*\d{1,2}\s*)?\+?\s*` +
+	`(?:years?|yrs|lat[a]?|jahre[n]?|años|ans|anni|лет|года|років|роки)(?:[^\p{L}]|$)`)
+
+// experienceWindow is the number of bytes around the years searched for an experience word.
+const experienceWindow = 60
+
+// Seniority derives the experience level from the title or, failing that, from the years of experience the
+// description requires: under two years is junior, under five mid and five or more senior.
+// Returns an empty seniority when neither announces it.
+func Seniority(title, description string) entity.Seniority {
+	if seniority := keyword.Find(strings.ToLower(title), seniorities, ""); seniority != "" {
+		return seniority
+	}
+
+	lower := strings.ToLower(description)
+	for _, loc := range years.FindAllStringSubmatchIndex(lower, -1) {
+		window := lower[max(0, loc[0]-experienceWindow):min(len(lower), loc[1]+experienceWindow)]
+		if !containsAny(window, experienceWords) {
+			continue
+		}
+
+		n, _ := strconv.Atoi(lower[loc[2]:loc[3]])
+		switch {
+		case n < 2:
+			return entity.SeniorityJunior
+		case n < 5:
+			return entity.SeniorityMid
+		default:
+			return entity.SenioritySenior
+		}
+	}
+	return ""
+}
+
+// containsAny reports whether text contains any of the words.
+func containsAny(text string, words []string) bool {
+	for _, word := range words {
+		if strings.Contains(text, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package classify
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/keyword"
+	"strings"
+)
+
+// workModes lists the words announcing where the work is done.
+var workModes = []keyword.Keyword[entity.WorkMode]{
+	{Words: []string{"hybrid", "hybryd", "hybride", "гибрид", "гібрид", "partially remote", "partly remote",
+		"teilweise remote"}, Value: entity.WorkModeHybrid},
+	{Words: []string{"remote", "zdaln", "home office", "homeoffice", "work from home", "wfh", "telecommute",
+		"telework", "fernarbeit", "télétravail", "teletrabajo", "удален", "віддален", "дистанц"},
+		Value: entity.WorkModeRemote},
+	{Words: []string{"on-site", "onsite", "on site", "in office", "office-based", "office based", "stacjonarn",
+		"vor ort", "présentiel", "presencial", "в офисе", "в офісі"}, Value: entity.WorkModeOnsite},
+}
+
+// WorkMode derives where the work is done from the first of the location, the title and the description that
+// announces it. Returns an empty mode when none does.
+func WorkMode(location, title, description string) entity.WorkMode {
+	for _, text := range []string{location, title, description} {
+		if mode := keyword.Find(strings.ToLower(text), workModes, ""); mode != "" {
+			return mode
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package company
+
+import (
+	_ "embed"
+	"fmt"
+	"infrastructure/fold"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// aliasesData lists the aliases of companies whose names differ beyond case, diacritics and legal suffixes.
+//
+//go:embed aliases.tsv
+var aliasesData string
+
+// suffixes are the legal forms stripped from the end of company names, as folded words.
+var suffixes = sortForms([]string{
+	// Polish
+	"sp z o o", "spolka z ograniczona odpowiedzialnoscia", "s a", "sa", "spolka akcyjna", "sp k", "sp j", "sp p",
+	"spolka komandytowa", "spolka jawna", "s k a", "ska",
+	// German, Austrian and Swiss
+	"gmbh", "gmbh co kg", "co kg", "kg", "ag", "ug", "ug haftungsbeschrankt", "se", "e v", "ohg",
+	// English
+	"ltd", "limited", "llc", "l l c", "llp", "inc", "incorporated", "corp", "corporation", "plc", "pty", "pty ltd",
+	// Other European
+	"bv", "b v", "nv", "n v", "sarl", "sas", "s a s", "srl", "s r l", "spa", "s p a", "s r o", "sro", "a s", "as",
+	"oy", "oyj", "ab", "aps", "s l", "sl", "lda",
+})
+
+// prefixes are the legal forms stripped from the start of company names, as folded words.
+var prefixes = sortForms([]string{
+	// Ukrainian and Russian
+	"тов", "ооо", "пп", "фоп", "ао", "пао",
+})
+
+// Normalizer reduces company names to a key shared by all the spellings of the same employer.
+type Normalizer struct {
+	aliases map[string]string // The keys of the companies by normalised alias.
+}
+
+// NewNormalizer creates and returns a new Normalizer instance backed by the embedded alias table.
+func NewNormalizer() (*Normalizer, error) {
+	n := &Normalizer{aliases: make(map[string]string)}
+
+	for i, line := range strings.Split(aliasesData, "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, aliases, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("load aliases: line %d: expected key and aliases", i+1)
+		}
+		key = n.normalize(key)
+		for _, alias := range strings.Split(aliases, ",") {
+			if alias = n.normalize(alias); alias != "" && alias != key {
+				n.aliases[alias] = key
+			}
+		}
+	}
+	return n, nil
+}
+
+// Key returns the key of the company named name, so that "ACME Sp. z o.o.", "Acme" and "ACME S.A." all give "acme".
+// It returns an empty key for names without any letter or digit.
+func (n *Normalizer) Key(name string) string {
+	key := n.normalize(name)
+	if alias, ok := n.aliases[key]; ok {
+		return alias
+	}
+	return key
+}
+
+// normalize folds name, replaces punctuation with spaces and strips the leading and trailing legal forms.
+func (n *Normalizer) normalize(name string) string {
+	words := strings.FieldsFunc(fold.String(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for stripped := true; stripped; {
+		stripped = false
+		for _, prefix := range prefixes {
+			if len(prefix) < len(words) && slices.Equal(words[:len(prefix)], prefix) {
+				words, stripped = words[len(prefix):], true
+				break
+			}
+		}
+	}
+	for stripped := true; stripped; {
+		stripped = false
+		for _, suffix := range suffixes {
+			if len(suffix) < len(words) && slices.Equal(words[len(words)-len(suffix):], suffix) {
+				words, stripped = words[:len(words)-len(suffix)], true
+				break
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// sortForms splits the legal forms into words, longest first, so that "gmbh co kg" is stripped before "kg".
+func sortForms(list []string) [][]string {
+	result := make([][]string, len(list))
+	for i, suffix := range list {
+		result[i] = strings.Fields(suffix)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return len(result[i]) > len(result[j]) })
+	return result
+}
@@ -0,0 +1,77 @@
+package company
+
+import (
+	"context"
+	"domain/company/entity"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository provides a MongoDB based implementation for managing companies.
+type Repository struct {
+	client     *mongo.Client     // MongoDB client instance.
+	collection *mongo.Collection // MongoDB collection for storing companies.
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(client *mongo.Client, collection *mongo.Collection) *Repository {
+	return &Repository{client: client, collection: collection}
+}
+
+// Upsert records that the company was seen under name at seenAt, creating the company if needed.
+// The update is idempotent, so re-crawls of the same vacancy do not change the company.
+func (r *Repository) Upsert(ctx context.Context, key, name string, seenAt time.Time) (*entity.Company, error) {
+	update := bson.M{
+		"$setOnInsert": bson.M{"name": name},
+		"$addToSet":    bson.M{"aliases": name},
+		"$min":         bson.M{"first_seen_at": seenAt},
+		"$max":         bson.M{"last_seen_at": seenAt},
+	}
+	return r.upsert(ctx, key, update)
+}
+
+// IncrementVacancies adds a newly stored vacancy to the count of the company.
+func (r *Repository) IncrementVacancies(ctx context.Context, key string) error {
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"key": key}, bson.M{"$inc": bson.M{"vacancies": 1}}); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// SetStatus sets the status of the company, creating it under name if needed.
+func (r *Repository) SetStatus(ctx context.Context, key, name string, status entity.Status) (*entity.Company, error) {
+	update := bson.M{
+		"$setOnInsert": bson.M{"name": name, "aliases": []string{name}},
+		"$set":         bson.M{"status": status},
+	}
+	return r.upsert(ctx, key, update)
+}
+
+// FetchByKey retrieves the company with the given key.
+func (r *Repository) FetchByKey(ctx context.Context, key string) (*entity.Company, error) {
+	var company entity.Company
+	if err := r.collection.FindOne(ctx, bson.M{"key": key}).Decode(&company); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &company, nil
+}
+
+// upsert applies update to the company with the given key, inserting it if it does not exist,
+// and returns the updated company.
+func (r *Repository) upsert(ctx context.Context, key string, update bson.M) (*entity.Company, error) {
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var company entity.Company
+	if err := r.collection.FindOneAndUpdate(ctx, bson.M{"key": key}, update, opts).Decode(&company); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &company, nil
+}
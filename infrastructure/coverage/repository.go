@@ -0,0 +1,83 @@
+package coverage
+
+import (
+	"context"
+	"domain/coverage/entity"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository provides a MongoDB based implementation for managing batch field coverage.
+type Repository struct {
+	client     *mongo.Client     // MongoDB client instance.
+	collection *mongo.Collection // MongoDB collection for storing coverage.
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(client *mongo.Client, collection *mongo.Collection) *Repository {
+	return &Repository{client: client, collection: collection}
+}
+
+// Save persists the coverage of a batch into the MongoDB collection.
+func (r *Repository) Save(ctx context.Context, coverage *entity.Coverage) error {
+	if coverage.ID.IsZero() {
+		coverage.ID = primitive.NewObjectID()
+	}
+	if _, err := r.collection.InsertOne(ctx, coverage); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// FetchBaseline retrieves the most recent batches of the source that did not drift and were not sparse,
+// newest first.
+func (r *Repository) FetchBaseline(ctx context.Context, source string, limit int) ([]*entity.Coverage, error) {
+	filter := bson.M{
+		"source": source,
+		"drift":  bson.M{"$exists": false},
+		"sparse": bson.M{"$ne": true},
+	}
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var list []*entity.Coverage
+	if err = cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return list, nil
+}
+
+// FetchPause retrieves the most recent batch of the source that pauses it beyond now.
+func (r *Repository) FetchPause(ctx context.Context, source string, now time.Time) (*entity.Coverage, error) {
+	filter := bson.M{
+		"source":       source,
+		"paused_until": bson.M{"$gt": now},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var coverage entity.Coverage
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&coverage); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &coverage, nil
+}
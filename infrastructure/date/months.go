@@ -0,0 +1,51 @@
+package date
+
+import (
+	"strings"
+	"time"
+)
+
+// monthPrefixes maps lowercase prefixes of month names and their abbreviations to months.
+// Prefixes cover the inflected forms used in dates, e.g. "марта" and "березня" for March.
+// Supported languages are English, German, Dutch, French, Spanish, Italian, Portuguese, Polish,
+// Russian and Ukrainian.
+var monthPrefixes = map[string]time.Month{
+	// English, German, Dutch.
+	"jan": time.January, "jän": time.January, "feb": time.February, "mar": time.March, "mär": time.March,
+	"mrz": time.March, "maa": time.March, "apr": time.April, "may": time.May, "mai": time.May, "mei": time.May,
+	"jun": time.June, "jul": time.July, "aug": time.August, "sep": time.September, "oct": time.October,
+	"okt": time.October, "nov": time.November, "dec": time.December, "dez": time.December,
+	// French.
+	"janv": time.January, "fév": time.February, "fev": time.February, "avr": time.April, "juin": time.June,
+	"juil": time.July, "aoû": time.August, "aou": time.August, "déc": time.December,
+	// Spanish, Italian, Portuguese.
+	"ene": time.January, "gen": time.January, "abr": time.April, "mag": time.May, "giu": time.June,
+	"lug": time.July, "ago": time.August, "set": time.September, "ott": time.October, "out": time.October,
+	"dic": time.December,
+	// Polish.
+	"sty": time.January, "lut": time.February, "kwi": time.April, "maj": time.May, "cze": time.June,
+	"lip": time.July, "sie": time.August, "wrz": time.September, "paź": time.October, "paz": time.October,
+	"lis": time.November, "gru": time.December,
+	// Russian.
+	"янв": time.January, "фев": time.February, "мар": time.March, "апр": time.April, "мая": time.May,
+	"май": time.May, "июн": time.June, "июл": time.July, "авг": time.August, "сен": time.September,
+	"окт": time.October, "ноя": time.November, "дек": time.December,
+	// Ukrainian.
+	"січ": time.January, "лют": time.February, "бер": time.March, "кві": time.April, "тра": time.May,
+	"чер": time.June, "лип": time.July, "сер": time.August, "вер": time.September, "жов": time.October,
+	"лис": time.November, "гру": time.December,
+}
+
+// month returns the month whose name the token starts with, preferring the longest matching prefix.
+func month(token string) (time.Month, bool) {
+	var (
+		found  time.Month
+		length int
+	)
+	for prefix, m := range monthPrefixes {
+		if len(prefix) > length && strings.HasPrefix(token, prefix) {
+			found, length = m, len(prefix)
+		}
+	}
+	return found, length > 0
+}
@@ -0,0 +1,177 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// layouts are the formats of absolute dates tried in order. Layouts without a zone are read in the reference location.
+var layouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.ANSIC,
+	"02.01.2006 15:04",
+	"02.01.2006",
+	"2.1.2006",
+}
+
+// clock matches a time of day written next to a localised date.
+var clock = regexp.MustCompile(`\b(\d{1,2}):(\d{2})\b`)
+
+// ErrUnknownFormat is returned when a value is not a date in any of the supported formats.
+var ErrUnknownFormat = errors.New("unknown date format")
+
+// Parse parses an absolute or relative date. Relative phrases are resolved against ref, dates without a zone
+// are read in the location of ref. The second result reports whether the value was a relative phrase.
+func Parse(value string, ref time.Time) (time.Time, bool, error) {
+	if t, err := ParseRelative(value, ref); err == nil {
+		return t, true, nil
+	}
+	t, err := ParseAbsolute(value, ref)
+	return t, false, err
+}
+
+// ParseAbsolute parses an ISO 8601, RFC 1123 or similar date, or a date with a localised month name
+// such as "3 March 2024", "March 3rd, 2024" or "3 марта 2024". A missing year is taken from ref, moved
+// one year back when the date would otherwise lie in the future. Dates without a zone are read in the location of ref.
+func ParseAbsolute(value string, ref time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, ErrUnknownFormat
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, ref.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return parseLocalised(value, ref)
+}
+
+// parseLocalised parses a date written with a month name in any of the supported languages.
+func parseLocalised(value string, ref time.Time) (time.Time, error) {
+	var (
+		tokens       = tokenize(strings.ToLower(value))
+		day, year    int
+		dayAt        = -1
+		hour, minute int
+	)
+
+	if m := clock.FindStringSubmatch(value); m != nil {
+		hour, _ = strconv.Atoi(m[1])
+		minute, _ = strconv.Atoi(m[2])
+		// The clock is not part of the date.
+		tokens = tokenize(strings.ToLower(strings.Replace(value, m[0], " ", 1)))
+	}
+
+	for i, token := range tokens {
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			continue
+		}
+		switch {
+		case len(token) == 4 && year == 0:
+			year = n
+		case len(token) <= 2 && n >= 1 && n <= 31 && dayAt < 0:
+			day, dayAt = n, i
+		}
+	}
+	if dayAt < 0 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownFormat, value)
+	}
+
+	// The month name closest to the day wins, so weekday names such as "martes" are not taken for months.
+	var (
+		found    time.Month
+		distance = len(tokens)
+	)
+	for i, token := range tokens {
+		if unicode.IsDigit([]rune(token)[0]) {
+			continue
+		}
+		if m, ok := month(token); ok && abs(i-dayAt) < distance {
+			found, distance = m, abs(i-dayAt)
+		}
+	}
+	if found == 0 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownFormat, value)
+	}
+
+	if year == 0 {
+		year = ref.Year()
+		if time.Date(year, found, day, 0, 0, 0, 0, ref.Location()).After(ref) {
+			year--
+		}
+	}
+
+	t := time.Date(year, found, day, hour, minute, 0, 0, ref.Location())
+	if t.Day() != day {
+		return time.Time{}, fmt.Errorf("%w: invalid day in %q", ErrUnknownFormat, value)
+	}
+	return t, nil
+}
+
+// tokenize splits text into runs of letters and runs of digits.
+func tokenize(text string) []string {
+	var (
+		tokens  []string
+		current []rune
+		digits  bool
+	)
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, string(current))
+			current = current[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsDigit(r):
+			if !digits {
+				flush()
+			}
+			digits = true
+			current = append(current, r)
+		case unicode.IsLetter(r):
+			if digits {
+				flush()
+			}
+			digits = false
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,140 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unit is a step of a relative date.
+type unit struct {
+	duration     time.Duration // duration is the length of units shorter than a day.
+	days, months int           // days and months are the calendar length of longer units.
+	years        int           // years is the calendar length of yearly units.
+	exact        bool          // exact units only match whole tokens, e.g. "d" in "2d ago".
+	prefix       string        // prefix is the lowercase prefix of the unit name.
+}
+
+// units lists the units of relative dates in the supported languages, matched by prefix.
+var units = []unit{
+	{prefix: "sec", duration: time.Second}, {prefix: "sek", duration: time.Second},
+	{prefix: "сек", duration: time.Second}, {prefix: "min", duration: time.Minute},
+	{prefix: "мин", duration: time.Minute}, {prefix: "хв", duration: time.Minute},
+	{prefix: "hour", duration: time.Hour}, {prefix: "hr", duration: time.Hour},
+	{prefix: "h", duration: time.Hour, exact: true}, {prefix: "stund", duration: time.Hour},
+	{prefix: "uur", duration: time.Hour}, {prefix: "heure", duration: time.Hour}, {prefix: "hora", duration: time.Hour},
+	{prefix: "ore", duration: time.Hour, exact: true}, {prefix: "ora", duration: time.Hour, exact: true},
+	{prefix: "godzin", duration: time.Hour}, {prefix: "час", duration: time.Hour},
+	{prefix: "годин", duration: time.Hour}, {prefix: "day", days: 1}, {prefix: "d", days: 1, exact: true},
+	{prefix: "tag", days: 1}, {prefix: "dag", days: 1}, {prefix: "jour", days: 1}, {prefix: "día", days: 1},
+	{prefix: "dia", days: 1}, {prefix: "giorn", days: 1}, {prefix: "dzie", days: 1}, {prefix: "dni", days: 1},
+	{prefix: "дн", days: 1}, {prefix: "день", days: 1}, {prefix: "week", days: 7}, {prefix: "w", days: 7, exact: true},
+	{prefix: "woche", days: 7}, {prefix: "semain", days: 7}, {prefix: "semana", days: 7}, {prefix: "settiman", days: 7},
+	{prefix: "tydz", days: 7}, {prefix: "tygod", days: 7}, {prefix: "недел", days: 7}, {prefix: "тиж", days: 7},
+	{prefix: "month", months: 1}, {prefix: "monat", months: 1}, {prefix: "maand", months: 1},
+	{prefix: "mois", months: 1}, {prefix: "mes", months: 1}, {prefix: "mês", months: 1}, {prefix: "miesi", months: 1},
+	{prefix: "месяц", months: 1}, {prefix: "місяц", months: 1}, {prefix: "year", years: 1}, {prefix: "jahr", years: 1},
+	{prefix: "jaar", years: 1}, {prefix: "an", years: 1, exact: true}, {prefix: "ans", years: 1, exact: true},
+	{prefix: "año", years: 1}, {prefix: "ano", years: 1, exact: true}, {prefix: "anos", years: 1, exact: true},
+	{prefix: "anno", years: 1, exact: true}, {prefix: "anni", years: 1, exact: true}, {prefix: "rok", years: 1},
+	{prefix: "lat", years: 1, exact: true}, {prefix: "год", years: 1}, {prefix: "лет", years: 1},
+	{prefix: "рік", years: 1}, {prefix: "рок", years: 1},
+}
+
+// pastMarkers are the words marking a phrase as lying in the past, e.g. "ago" in "3 days ago".
+var pastMarkers = map[string]struct{}{
+	"ago": {}, "vor": {}, "geleden": {}, "il": {}, "hace": {}, "fa": {}, "há": {}, "atrás": {}, "atras": {},
+	"temu": {}, "назад": {}, "тому": {},
+}
+
+// numbers are the words used instead of the number one, e.g. "an" in "an hour ago".
+var numbers = map[string]struct{}{
+	"a": {}, "an": {}, "one": {}, "ein": {}, "einem": {}, "einer": {}, "een": {}, "un": {}, "une": {},
+	"uno": {}, "una": {}, "um": {}, "uma": {},
+}
+
+// offsets are the words naming a day relative to today, by the number of days back.
+var offsets = map[string]int{
+	"now": 0, "today": 0, "heute": 0, "vandaag": 0, "aujourd": 0, "hoy": 0, "oggi": 0, "hoje": 0,
+	"dzisiaj": 0, "dziś": 0, "сегодня": 0, "сьогодні": 0, "только": 0, "щойно": 0,
+	"yesterday": 1, "gestern": 1, "gisteren": 1, "hier": 1, "ayer": 1, "ieri": 1, "ontem": 1,
+	"wczoraj": 1, "вчера": 1, "вчора": 1,
+	"vorgestern": 2, "eergisteren": 2, "avant": 2, "anteayer": 2, "позавчера": 2, "позавчора": 2,
+}
+
+// ParseRelative resolves a relative phrase such as "3 days ago", "yesterday", "vor 2 Stunden" or
+// "2 дня назад" against ref.
+func ParseRelative(value string, ref time.Time) (time.Time, error) {
+	tokens := tokenize(strings.ToLower(value))
+	if len(tokens) == 0 {
+		return time.Time{}, ErrUnknownFormat
+	}
+
+	if n, ok := count(tokens); ok {
+		if u, found := findUnit(tokens); found && isPast(tokens) {
+			return u.before(ref, n), nil
+		}
+	}
+	for _, token := range tokens {
+		if days, ok := offsets[token]; ok {
+			return ref.AddDate(0, 0, -days), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownFormat, value)
+}
+
+// count returns the number of units in the phrase, digits win over words such as "an".
+func count(tokens []string) (int, bool) {
+	for _, token := range tokens {
+		if n, err := strconv.Atoi(token); err == nil {
+			return n, true
+		}
+	}
+	for _, token := range tokens {
+		if _, ok := numbers[token]; ok {
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+// findUnit returns the first unit named in the phrase after its count.
+// Words for one are only taken for units when they follow the count, as "an" in "il y a un an".
+func findUnit(tokens []string) (unit, bool) {
+	counted := false
+	for _, token := range tokens {
+		_, isNumber := numbers[token]
+		if _, err := strconv.Atoi(token); err == nil || (isNumber && !counted) {
+			counted = true
+			continue
+		}
+		if !counted {
+			continue
+		}
+		for _, u := range units {
+			if token == u.prefix || (!u.exact && strings.HasPrefix(token, u.prefix)) {
+				return u, true
+			}
+		}
+	}
+	return unit{}, false
+}
+
+// isPast reports whether the phrase is marked as lying in the past.
+func isPast(tokens []string) bool {
+	for _, token := range tokens {
+		if _, ok := pastMarkers[token]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// before returns the time n units before ref.
+func (u unit) before(ref time.Time, n int) time.Time {
+	if u.duration > 0 {
+		return ref.Add(-time.Duration(n) * u.duration)
+	}
+	return ref.AddDate(-n*u.years, -n*u.months, -n*u.days)
+}
@@ -3,10 +3,16 @@ package infrastructure
 import (
 	"application/config"
 	"application/dependency"
+	companyRepo "domain/company/repository"
+	coverageRepo "domain/coverage/repository"
+	runRepo "domain/run/repository"
+	snapshotRepo "domain/snapshot/repository"
 	"domain/url/repository"
 	"domain/useragent"
 	vacancyRepo "domain/vacancy/repository"
 	"fmt"
+	"infrastructure/company"
+	"infrastructure/coverage"
 	authClient "infrastructure/grpc/auth/client"
 	vacancyClient "infrastructure/grpc/vacancy/client"
 	httpClient "infrastructure/http/client"
@@ -14,9 +20,12 @@ import (
 	proxyClient "infrastructure/proxy/client"
 	proxyAgent "infrastructure/proxy/client/agent"
 	proxyPort "infrastructure/proxy/port"
+	"infrastructure/run"
+	"infrastructure/snapshot"
 	"infrastructure/url"
 	"infrastructure/vacancy"
 	"log"
+	"path/filepath"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -24,15 +33,19 @@ import (
 
 // Container provides a lazily initialized set of dependencies for the infrastructure layer.
 type Container struct {
-	ProxyConnection   dependency.LazyDependency[*proxyPort.Connection]
-	UserAgent         dependency.LazyDependency[useragent.Generator]
-	Socks5Client      dependency.LazyDependency[*proxyClient.Socks5Client]
-	HttpFactory       dependency.LazyDependency[*httpClient.Factory]
-	MongoClient       dependency.LazyDependency[*mongo.Client]
-	UrlRepository     dependency.LazyDependency[repository.UrlRepository]
-	VacancyRepository dependency.LazyDependency[vacancyRepo.VacancyRepository]
-	AuthClient        dependency.LazyDependency[*authClient.AuthClient]
-	VacancyClient     dependency.LazyDependency[*vacancyClient.VacancyClient]
+	ProxyConnection    dependency.LazyDependency[*proxyPort.Connection]
+	UserAgent          dependency.LazyDependency[useragent.Generator]
+	Socks5Client       dependency.LazyDependency[*proxyClient.Socks5Client]
+	HttpFactory        dependency.LazyDependency[*httpClient.Factory]
+	MongoClient        dependency.LazyDependency[*mongo.Client]
+	UrlRepository      dependency.LazyDependency[repository.UrlRepository]
+	VacancyRepository  dependency.LazyDependency[vacancyRepo.VacancyRepository]
+	RunRepository      dependency.LazyDependency[runRepo.RunRepository]
+	CoverageRepository dependency.LazyDependency[coverageRepo.CoverageRepository]
+	CompanyRepository  dependency.LazyDependency[companyRepo.CompanyRepository]
+	SnapshotStore      dependency.LazyDependency[snapshotRepo.SnapshotRepository]
+	AuthClient         dependency.LazyDependency[*authClient.AuthClient]
+	VacancyClient      dependency.LazyDependency[*vacancyClient.VacancyClient]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the infrastructure layer.
@@ -86,6 +99,36 @@ func NewContainer(cfg *config.Config) *Container {
 			return vacancy.NewRepository(mongoClient, collection)
 		},
 	}
+	c.RunRepository = dependency.LazyDependency[runRepo.RunRepository]{
+		InitFunc: func() runRepo.RunRepository {
+			mongoClient := c.MongoClient.Get()
+			collection := mongoClient.Database(cfg.Mongo.DB).Collection(cfg.Mongo.RunsCollection)
+			return run.NewRepository(mongoClient, collection)
+		},
+	}
+	c.CoverageRepository = dependency.LazyDependency[coverageRepo.CoverageRepository]{
+		InitFunc: func() coverageRepo.CoverageRepository {
+			mongoClient := c.MongoClient.Get()
+			collection := mongoClient.Database(cfg.Mongo.DB).Collection(cfg.Mongo.CoverageCollection)
+			return coverage.NewRepository(mongoClient, collection)
+		},
+	}
+	c.CompanyRepository = dependency.LazyDependency[companyRepo.CompanyRepository]{
+		InitFunc: func() companyRepo.CompanyRepository {
+			mongoClient := c.MongoClient.Get()
+			collection := mongoClient.Database(cfg.Mongo.DB).Collection(cfg.Mongo.CompanyCollection)
+			return company.NewRepository(mongoClient, collection)
+		},
+	}
+	c.SnapshotStore = dependency.LazyDependency[snapshotRepo.SnapshotRepository]{
+		InitFunc: func() snapshotRepo.SnapshotRepository {
+			// A relative directory would depend on the working directory of the process.
+			if !filepath.IsAbs(cfg.Snapshot.Dir) {
+				log.Fatalf("snapshot directory must be an absolute path, got %q", cfg.Snapshot.Dir)
+			}
+			return snapshot.NewRepository(cfg.Snapshot.Dir)
+		},
+	}
 	c.AuthClient = dependency.LazyDependency[*authClient.AuthClient]{
 		InitFunc: func() *authClient.AuthClient {
 			env := cfg.Env
@@ -0,0 +1,72 @@
+// Package fingerprint computes the keys used to recognise the same vacancy posted on several boards.
+package fingerprint
+
+import (
+	"encoding/hex"
+	"hash/fnv"
+	"infrastructure/fold"
+	"math/bits"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// shingleSize is the number of consecutive words hashed together into a SimHash feature.
+const shingleSize = 3
+
+// qualifiers matches the parenthesised qualifiers boards add to titles, such as "(m/w/d)" or "[Remote]".
+var qualifiers = regexp.MustCompile(`\([^)]*\)|\[[^]]*]`)
+
+// Key returns the fingerprint of a vacancy from its title, company and location, which are normalised so that
+// "Senior Go Developer (m/w/d)" at "Acme" in "Kraków" and "senior go developer" at "ACME" in "Krakow" match.
+func Key(title, company, location string) string {
+	h := fnv.New64a()
+	for _, part := range []string{qualifiers.ReplaceAllString(title, " "), company, location} {
+		_, _ = h.Write([]byte(strings.Join(words(part), " ")))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SimHash returns the 64-bit SimHash of text over its word shingles, so that texts differing in a few words
+// have hashes differing in a few bits.
+func SimHash(text string) uint64 {
+	tokens := words(text)
+	if len(tokens) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= max(len(tokens), shingleSize); i++ {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(strings.Join(tokens[i:min(i+shingleSize, len(tokens))], " ")))
+		feature := h.Sum64()
+		for bit := range weights {
+			if feature&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var hash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return hash
+}
+
+// Distance returns the number of bits two SimHashes differ in.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// words returns the folded words of text, dropping punctuation.
+func words(text string) []string {
+	return strings.FieldsFunc(fold.String(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
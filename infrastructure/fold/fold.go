@@ -0,0 +1,31 @@
+// Package fold reduces names to a comparable form.
+package fold
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// letters maps the letters that do not decompose into a base letter and a diacritic.
+var letters = map[rune]string{
+	'ł': "l", 'ß': "ss", 'ø': "o", 'æ': "ae", 'œ': "oe", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i",
+}
+
+// String lower-cases s, strips its diacritics and collapses whitespace, so that "  Kraków" and "KRAKOW" fold to
+// the same "krakow". Letters of other scripts, such as Cyrillic, are kept apart from being lower-cased.
+func String(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case letters[r] != "":
+			b.WriteString(letters[r])
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
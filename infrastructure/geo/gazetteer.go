@@ -0,0 +1,163 @@
+package geo
+
+import (
+	_ "embed"
+	"fmt"
+	"infrastructure/fold"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// citiesData lists the cities of the gazetteer, a subset of GeoNames.
+	//
+	//go:embed cities.tsv
+	citiesData string
+
+	// countriesData lists the countries of the gazetteer.
+	//
+	//go:embed countries.tsv
+	countriesData string
+
+	// subdivisionsData lists the states, provinces and other first-level subdivisions of some countries.
+	//
+	//go:embed subdivisions.tsv
+	subdivisionsData string
+)
+
+// City is a city of the gazetteer.
+type City struct {
+	Name       string  // The canonical, English name of the city.
+	Country    string  // The ISO 3166-1 alpha-2 code of the country.
+	Latitude   float64 // The latitude in degrees.
+	Longitude  float64 // The longitude in degrees.
+	Population int     // The population, used to rank cities sharing a name.
+}
+
+// Gazetteer resolves city, country and subdivision names, in any of their known spellings, without any external
+// service.
+type Gazetteer struct {
+	cities       map[string][]City // The cities by folded name, most populous first.
+	countries    map[string]string // The country codes by folded name or code.
+	subdivisions map[string]string // The country codes of the subdivisions by folded name.
+}
+
+// NewGazetteer creates and returns a new Gazetteer instance backed by the embedded data.
+func NewGazetteer() (*Gazetteer, error) {
+	g := &Gazetteer{
+		cities:       make(map[string][]City),
+		countries:    make(map[string]string),
+		subdivisions: make(map[string]string),
+	}
+
+	if err := rows(countriesData, 2, func(fields []string) error {
+		g.countries[fold.String(fields[0])] = fields[0]
+		for _, name := range names(fields[1:]) {
+			g.countries[fold.String(name)] = fields[0]
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("load countries: %w", err)
+	}
+
+	if err := rows(subdivisionsData, 2, func(fields []string) error {
+		for _, name := range names(fields[1:]) {
+			g.subdivisions[fold.String(name)] = fields[0]
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("load subdivisions: %w", err)
+	}
+
+	if err := rows(citiesData, 5, func(fields []string) error {
+		city := City{Name: fields[0], Country: fields[1]}
+		var err error
+		if city.Latitude, err = strconv.ParseFloat(fields[2], 64); err != nil {
+			return fmt.Errorf("latitude of %s: %w", city.Name, err)
+		}
+		if city.Longitude, err = strconv.ParseFloat(fields[3], 64); err != nil {
+			return fmt.Errorf("longitude of %s: %w", city.Name, err)
+		}
+		if city.Population, err = strconv.Atoi(fields[4]); err != nil {
+			return fmt.Errorf("population of %s: %w", city.Name, err)
+		}
+
+		seen := make(map[string]struct{})
+		for _, name := range names(append([]string{fields[0]}, fields[5:]...)) {
+			key := fold.String(name)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			g.cities[key] = append(g.cities[key], city)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("load cities: %w", err)
+	}
+
+	for _, candidates := range g.cities {
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Population > candidates[j].Population })
+	}
+	return g, nil
+}
+
+// City returns the city with the given name, preferring one in the given country, which may be empty, over the
+// most populous one.
+func (g *Gazetteer) City(name, country string) (City, bool) {
+	candidates := g.cities[fold.String(name)]
+	if len(candidates) == 0 {
+		return City{}, false
+	}
+	for _, city := range candidates {
+		if city.Country == country {
+			return city, true
+		}
+	}
+	return candidates[0], true
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country with the given name or code.
+func (g *Gazetteer) Country(name string) (string, bool) {
+	code, ok := g.countries[fold.String(name)]
+	return code, ok
+}
+
+// Subdivision returns the ISO 3166-1 alpha-2 code of the country of the state, province or other first-level
+// subdivision with the given name.
+func (g *Gazetteer) Subdivision(name string) (string, bool) {
+	code, ok := g.subdivisions[fold.String(name)]
+	return code, ok
+}
+
+// rows calls fn with the tab-separated fields of every line of data, skipping comments and blank lines.
+// Lines with fewer than minFields fields are rejected.
+func rows(data string, minFields int, fn func(fields []string) error) error {
+	for i, line := range strings.Split(data, "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < minFields {
+			return fmt.Errorf("line %d: expected %d fields, got %d", i+1, minFields, len(fields))
+		}
+		if err := fn(fields); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// names splits the comma-separated name lists, dropping empty names.
+func names(fields []string) []string {
+	var result []string
+	for _, field := range fields {
+		for _, name := range strings.Split(field, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				result = append(result, name)
+			}
+		}
+	}
+	return result
+}
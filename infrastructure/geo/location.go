@@ -0,0 +1,212 @@
+package geo
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/fold"
+	"regexp"
+	"strings"
+)
+
+// separator splits multi-location strings such as "Warsaw; Kraków" or "Berlin or Remote".
+var separator = regexp.MustCompile(`(?i)\s*(?:[;|/\n•]|\s[-–]\s|\s(?:or|lub|oder|ou|and|und|i|&)\s)\s*`)
+
+// qualifier matches the parenthesised qualifiers of a location, such as "(EU)" in "Remote (EU)".
+var qualifier = regexp.MustCompile(`\(([^)]*)\)`)
+
+// regions maps the folded region qualifiers to their canonical name.
+var regions = map[string]string{
+	"eu": "EU", "european union": "EU", "eea": "EEA", "europe": "Europe", "europa": "Europe", "emea": "EMEA",
+	"cet": "CET", "cest": "CET", "cee": "CEE", "dach": "DACH", "nordics": "Nordics", "latam": "LATAM",
+	"apac": "APAC", "americas": "Americas", "north america": "North America", "worldwide": "Worldwide",
+	"global": "Worldwide", "anywhere": "Worldwide",
+}
+
+// remoteWords announce remote work, the longest first so that they are removed as a whole.
+var remoteWords = []string{
+	"praca zdalna", "work from home", "fully remote", "100% remote", "remote-first", "home office", "homeoffice",
+	"zdalnie", "zdalna", "remote", "віддалено", "удаленно", "teletravail",
+}
+
+// connectors are the words left over once a remote word is removed, as in "Remote from Poland".
+var connectors = []string{"from", "in", "within", "only", "z", "aus", "-", ":"}
+
+// ignored are the qualifiers that carry no location, such as "(Hybrid)".
+var ignored = map[string]struct{}{
+	"hybrid": {}, "hybrydowo": {}, "praca hybrydowa": {}, "hybryda": {}, "on-site": {}, "onsite": {}, "office": {},
+	"hq": {}, "headquarters": {}, "stacjonarnie": {}, "multiple locations": {},
+}
+
+// Parser normalises the raw location of a vacancy into places.
+type Parser struct {
+	gazetteer *Gazetteer // The gazetteer resolving city and country names.
+}
+
+// NewParser creates and returns a new Parser instance.
+func NewParser(gazetteer *Gazetteer) *Parser {
+	return &Parser{gazetteer: gazetteer}
+}
+
+// Parse splits a raw location such as "Warsaw, Poland; Remote (EU)" into places.
+// Cities are resolved to their country and coordinates, remote work is recognised together with its region or
+// country restriction, and names missing from the gazetteer are kept as city names without coordinates.
+// Returns nil for empty locations and placeholders such as "Unknown Location".
+func (p *Parser) Parse(raw string) []entity.Place {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || entity.IsPlaceholder(raw) {
+		return nil
+	}
+
+	var places []entity.Place
+	for _, segment := range separator.Split(raw, -1) {
+		for _, place := range p.segment(segment) {
+			if !contains(places, place) {
+				places = append(places, place)
+			}
+		}
+	}
+	return places
+}
+
+// segment parses a single location such as "Kraków, Poland (Hybrid)", whose parts describe one or more places.
+func (p *Parser) segment(segment string) []entity.Place {
+	parts := strings.Split(qualifier.ReplaceAllString(segment, ""), ",")
+	for _, match := range qualifier.FindAllStringSubmatch(segment, -1) {
+		parts = append(parts, strings.Split(match[1], ",")...)
+	}
+
+	// The country of the segment disambiguates cities sharing a name, whatever its position.
+	var country string
+	for _, part := range parts {
+		if code, ok := p.gazetteer.Country(part); ok {
+			country = code
+		}
+	}
+
+	var places []entity.Place
+	for i, part := range parts {
+		// A qualifier naming a country or subdivision, as "Ontario" in "London, Ontario", pins the city before it.
+		pin, pinned := country, false
+		if i+1 < len(parts) {
+			pin, pinned = p.qualifier(parts[i+1])
+			if !pinned {
+				pin = country
+			}
+		}
+		places = p.part(places, part, pin, pinned)
+	}
+	return places
+}
+
+// qualifier returns the country the part names, directly or through one of its subdivisions.
+// Parts that are also cities, such as "Singapore" or "New York", may just be the next city of a list.
+func (p *Parser) qualifier(part string) (string, bool) {
+	if _, ok := p.gazetteer.City(part, ""); ok {
+		return "", false
+	}
+	if code, ok := p.gazetteer.Country(part); ok {
+		return code, true
+	}
+	return p.gazetteer.Subdivision(part)
+}
+
+// part adds the place described by a single part of a segment, or refines the last place with it.
+// A city pinned to a country is left unresolved when the gazetteer only knows a namesake in another country.
+func (p *Parser) part(places []entity.Place, part, country string, pinned bool) []entity.Place {
+	folded := fold.String(part)
+	if folded == "" {
+		return places
+	}
+	var last *entity.Place
+	if len(places) > 0 {
+		last = &places[len(places)-1]
+	}
+
+	if rest, ok := trimRemote(folded); ok {
+		switch {
+		case last != nil && last.City == "" && !last.Remote:
+			last.Remote = true // "Poland (Remote)"
+		case last != nil:
+			places = append(places, entity.Place{Remote: true, Country: last.Country}) // "Warsaw (Remote)"
+		default:
+			places = append(places, entity.Place{Remote: true})
+		}
+		return p.part(places, rest, country, pinned)
+	}
+	if _, ok := ignored[folded]; ok {
+		return places
+	}
+
+	if region, ok := regions[folded]; ok {
+		if last != nil && last.Remote && last.Region == "" {
+			last.Region = region
+			return places
+		}
+		return append(places, entity.Place{Region: region})
+	}
+
+	if city, ok := p.gazetteer.City(part, country); ok {
+		if pinned && city.Country != country {
+			return append(places, entity.Place{City: strings.TrimSpace(part), Country: country})
+		}
+		return append(places, entity.Place{
+			City:    city.Name,
+			Country: city.Country,
+			Point:   entity.NewGeoPoint(city.Latitude, city.Longitude),
+		})
+	}
+
+	if code, ok := p.gazetteer.Country(part); ok {
+		switch {
+		case last != nil && last.Country == "":
+			last.Country = code
+		case last != nil && last.Country == code:
+		default:
+			places = append(places, entity.Place{Country: code})
+		}
+		return places
+	}
+
+	// Unknown names following a place are districts, states or regions of it; otherwise keep them as a city.
+	if last != nil {
+		return places
+	}
+	return append(places, entity.Place{City: strings.TrimSpace(part)})
+}
+
+// trimRemote reports whether the folded part announces remote work, and returns what is left once the remote
+// word and the connectors following it are removed.
+func trimRemote(folded string) (string, bool) {
+	for _, word := range remoteWords {
+		i := strings.Index(folded, word)
+		if i < 0 {
+			continue
+		}
+
+		fields := strings.Fields(folded[:i] + " " + folded[i+len(word):])
+		for len(fields) > 0 && isConnector(fields[0]) {
+			fields = fields[1:]
+		}
+		return strings.Join(fields, " "), true
+	}
+	return "", false
+}
+
+// isConnector reports whether word is a connector.
+func isConnector(word string) bool {
+	for _, connector := range connectors {
+		if word == connector {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether places already holds an equal place.
+func contains(places []entity.Place, place entity.Place) bool {
+	for _, p := range places {
+		if p.City == place.City && p.Country == place.Country && p.Region == place.Region && p.Remote == place.Remote {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package html
+
+import (
+	"bytes"
+	"domain/pipeline"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// closedMarkers are lowercase fragments boards show on the pages of closed postings.
+var closedMarkers = []string{
+	"this job is no longer available",
+	"this job has expired",
+	"this position has been filled",
+	"no longer accepting applications",
+	"вакансія закрита",
+	"вакансія більше не актуальна",
+	"вакансия закрыта",
+	"вакансия больше не актуальна",
+	"diese stelle ist nicht mehr verfügbar",
+	"ogłoszenie wygasło",
+}
+
+// postingContainers select the element holding the posting, the first one found is searched for closed markers.
+var postingContainers = []string{
+	"[itemtype*='JobPosting']",
+	"main",
+	"[role='main']",
+	"article",
+}
+
+// widgetSelectors select the parts of a page listing other postings, whose closed markers are not the posting's.
+var widgetSelectors = "aside, nav, footer, [class*='related'], [class*='similar'], [class*='recommend']"
+
+// listingSegments are the path segments of the listing and category pages boards redirect closed postings to.
+var listingSegments = map[string]struct{}{
+	"jobs":       {},
+	"job":        {},
+	"vacancies":  {},
+	"vacancy":    {},
+	"careers":    {},
+	"career":     {},
+	"search":     {},
+	"category":   {},
+	"categories": {},
+	"vakansii":   {},
+	"vakansiyi":  {},
+	"stellen":    {},
+	"oferty":     {},
+}
+
+// authFragments are fragments of the paths of login and consent pages, which are never listings.
+var authFragments = []string{"login", "signin", "sign-in", "auth", "consent"}
+
+// CheckPosting classifies the response of a posting page, requested from the given address.
+// It returns a closed error for postings that are gone (404, 410), that redirect to a listing page or that
+// announce they are closed, and otherwise classifies the response as CheckResponse does.
+func CheckPosting(requested string, statusCode int, finalURL string, body []byte) error {
+	switch {
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		return pipeline.Closed(statusCode, errors.New("posting removed"))
+	case IsListingRedirect(requested, finalURL):
+		return pipeline.Closed(statusCode, errors.New("redirected to listing "+finalURL))
+	case statusCode == http.StatusOK && IsClosedPage(body):
+		return pipeline.Closed(statusCode, errors.New("posting closed"))
+	}
+	return CheckResponse(statusCode, body)
+}
+
+// IsListingRedirect reports whether a posting requested from requested was redirected to a listing page.
+// Boards redirect closed postings to the home page or to a listing or category page, whose path is shorter than
+// the posting path, contains a known listing segment and does not end in a job ID. Redirects to login and
+// consent pages do not count, the posting may still be open.
+func IsListingRedirect(requested, finalURL string) bool {
+	from, err := url.Parse(requested)
+	if err != nil || finalURL == "" {
+		return false
+	}
+	to, err := url.Parse(finalURL)
+	if err != nil {
+		return false
+	}
+	if strings.TrimPrefix(from.Hostname(), "www.") != strings.TrimPrefix(to.Hostname(), "www.") {
+		return false
+	}
+
+	fromSegments, toSegments := segments(from.Path), segments(to.Path)
+	if len(toSegments) >= len(fromSegments) {
+		return false
+	}
+	if len(toSegments) == 0 {
+		return true
+	}
+	if strings.ContainsAny(toSegments[len(toSegments)-1], "0123456789") {
+		return false
+	}
+
+	listing := false
+	for _, segment := range toSegments {
+		segment = strings.ToLower(segment)
+		for _, fragment := range authFragments {
+			if strings.Contains(segment, fragment) {
+				return false
+			}
+		}
+		if _, ok := listingSegments[segment]; ok {
+			listing = true
+		}
+	}
+	return listing
+}
+
+// IsClosedPage reports whether the body looks like the page of a closed posting.
+// Only the leading bytes of the body are inspected, and only the text of the posting container, or of the whole
+// page if there is none, without the widgets listing other postings.
+func IsClosedPage(body []byte) bool {
+	if len(body) > inspectSize {
+		body = body[:inspectSize]
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	container := doc.Find("body")
+	for _, selector := range postingContainers {
+		if found := doc.Find(selector).First(); found.Length() > 0 {
+			container = found
+			break
+		}
+	}
+	container.Find(widgetSelectors).Remove()
+
+	text := strings.ToLower(strings.Join(strings.Fields(container.Text()), " "))
+	for _, marker := range closedMarkers {
+		if strings.Contains(text, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// segments returns the non-empty segments of a URL path.
+func segments(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+}
@@ -0,0 +1,104 @@
+package html
+
+import (
+	"domain/vacancy/entity"
+	"encoding/json"
+	"infrastructure/date"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// postedMeta are the meta tags announcing when a page was published.
+var postedMeta = []string{
+	"meta[property='article:published_time']",
+	"meta[property='og:published_time']",
+	"meta[itemprop='datePosted']",
+	"meta[name='date']",
+	"meta[name='publish-date']",
+	"meta[name='pubdate']",
+}
+
+// PostedAt extracts the posting date of a vacancy page and how confident the extraction is.
+// Structured data wins over text: JSON-LD, meta tags and microdata first, then the candidate texts found by the
+// parser, then time elements. Relative phrases are resolved against fetchedAt, which is also returned when no
+// date is found at all.
+func PostedAt(doc *goquery.Document, fetchedAt time.Time, candidates ...string) (time.Time, entity.DateConfidence) {
+	for _, value := range structuredDates(doc) {
+		if t, err := date.ParseAbsolute(value, fetchedAt); err == nil {
+			return t, entity.DateConfidenceHigh
+		}
+	}
+
+	for _, text := range candidates {
+		t, relative, err := date.Parse(text, fetchedAt)
+		switch {
+		case err != nil:
+			continue
+		case relative:
+			return t, entity.DateConfidenceLow
+		default:
+			return t, entity.DateConfidenceMedium
+		}
+	}
+
+	if value, ok := doc.Find("time[datetime]").First().Attr("datetime"); ok {
+		if t, err := date.ParseAbsolute(value, fetchedAt); err == nil {
+			return t, entity.DateConfidenceMedium
+		}
+	}
+	return fetchedAt, entity.DateConfidenceNone
+}
+
+// structuredDates returns the publication dates announced in JSON-LD, meta tags and microdata, in that order.
+func structuredDates(doc *goquery.Document) []string {
+	var values []string
+
+	doc.Find("script[type='application/ld+json']").Each(func(_ int, s *goquery.Selection) {
+		values = append(values, jsonLDDates(s.Text())...)
+	})
+	for _, selector := range postedMeta {
+		if value, ok := doc.Find(selector).First().Attr("content"); ok {
+			values = append(values, value)
+		}
+	}
+	doc.Find("[itemprop='datePosted']").Each(func(_ int, s *goquery.Selection) {
+		for _, name := range []string{"datetime", "content"} {
+			if value, ok := s.Attr(name); ok {
+				values = append(values, value)
+				return
+			}
+		}
+		values = append(values, strings.TrimSpace(s.Text()))
+	})
+	return values
+}
+
+// jsonLDDates returns the datePosted values of the objects in a JSON-LD document, including those in a @graph.
+func jsonLDDates(data string) []string {
+	var (
+		document any
+		dates    []string
+		walk     func(v any)
+	)
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return nil
+	}
+
+	walk = func(v any) {
+		switch node := v.(type) {
+		case []any:
+			for _, item := range node {
+				walk(item)
+			}
+		case map[string]any:
+			if posted, ok := node["datePosted"].(string); ok {
+				dates = append(dates, posted)
+			}
+			walk(node["@graph"])
+		}
+	}
+	walk(document)
+	return dates
+}
@@ -0,0 +1,54 @@
+package html
+
+import (
+	"bytes"
+	"domain/pipeline"
+	"errors"
+	"net/http"
+)
+
+// inspectSize is the number of leading body bytes searched for block page markers.
+const inspectSize = 64 * 1024
+
+// blockMarkers are lowercase fragments found on anti-bot challenge and ban pages.
+var blockMarkers = [][]byte{
+	[]byte("attention required! | cloudflare"),
+	[]byte("cf-challenge"),
+	[]byte("challenge-platform"),
+	[]byte("captcha-delivery"),
+	[]byte("px-captcha"),
+	[]byte("are you a robot"),
+	[]byte("unusual traffic from your computer"),
+	[]byte("<title>access denied</title>"),
+}
+
+// CheckResponse classifies a response by its status code and body.
+// It returns nil for a regular page, a blocked error for ban, rate limit and captcha pages,
+// and an HTTP status error for any other unexpected status code.
+func CheckResponse(statusCode int, body []byte) error {
+	blocked := IsBlockPage(body)
+
+	switch {
+	case statusCode == http.StatusTooManyRequests || statusCode == http.StatusForbidden:
+		return pipeline.Blocked(statusCode, errors.New("request refused"))
+	case blocked:
+		return pipeline.Blocked(statusCode, errors.New("challenge page"))
+	case statusCode != http.StatusOK:
+		return pipeline.HTTPStatus(statusCode)
+	}
+	return nil
+}
+
+// IsBlockPage reports whether the body looks like an anti-bot challenge or ban page.
+func IsBlockPage(body []byte) bool {
+	if len(body) > inspectSize {
+		body = body[:inspectSize]
+	}
+	body = bytes.ToLower(body)
+	for _, marker := range blockMarkers {
+		if bytes.Contains(body, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package sanitize
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	spaces     = regexp.MustCompile(`\s+`)    // spaces matches runs of whitespace collapsed in text.
+	blankLines = regexp.MustCompile(`\n{3,}`) // blankLines matches runs of empty lines.
+)
+
+// headings maps heading elements to their level.
+var headings = map[atom.Atom]int{atom.H1: 1, atom.H2: 2, atom.H3: 3, atom.H4: 4, atom.H5: 5, atom.H6: 6}
+
+// Markdown converts an HTML fragment, typically the output of Sanitize, into Markdown.
+// Elements without a Markdown equivalent are reduced to their text, so the result also reads as plain text.
+func Markdown(fragment string) string {
+	nodes, err := parseFragment(fragment)
+	if err != nil {
+		return ""
+	}
+
+	r := &renderer{}
+	for _, n := range nodes {
+		r.node(n)
+	}
+	return normalize(r.b.String())
+}
+
+// renderer writes Markdown for a tree of nodes.
+type renderer struct {
+	b       strings.Builder
+	pending int    // pending is the number of line breaks written before the next content.
+	lists   []list // lists is the stack of lists the renderer is in.
+	pre     bool   // pre is set inside preformatted text, whose whitespace is kept.
+	marker  bool   // marker is set right after a list marker, whose item continues on the same line.
+}
+
+// list is a list being rendered.
+type list struct {
+	ordered bool // ordered is set for numbered lists.
+	index   int  // index is the number of items rendered so far.
+}
+
+// node renders a node and its children.
+func (r *renderer) node(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		r.text(n.Data)
+	case html.DocumentNode:
+		r.children(n)
+	case html.ElementNode:
+		r.element(n)
+	}
+}
+
+// children renders the children of a node.
+func (r *renderer) children(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		r.node(c)
+	}
+}
+
+// element renders an element as its Markdown equivalent.
+func (r *renderer) element(n *html.Node) {
+	if _, ok := dropped[n.DataAtom]; ok {
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		r.breaks(1)
+	case atom.Hr:
+		r.breaks(2)
+		r.write("---")
+		r.breaks(2)
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		r.breaks(2)
+		r.write(strings.Repeat("#", headings[n.DataAtom]) + " " + strings.TrimSpace(r.inline(n)))
+		r.breaks(2)
+	case atom.Ul, atom.Ol:
+		r.list(n)
+	case atom.Li:
+		r.item(n)
+	case atom.Strong, atom.B:
+		r.wrap(r.inline(n), "**")
+	case atom.Em, atom.I:
+		r.wrap(r.inline(n), "_")
+	case atom.Code:
+		r.wrap(r.inline(n), "`")
+	case atom.A:
+		r.link(n)
+	case atom.Pre:
+		r.breaks(2)
+		r.write("```\n" + strings.Trim(r.sub(n, true), "\n") + "\n```")
+		r.breaks(2)
+	case atom.Blockquote:
+		r.breaks(2)
+		r.write("> " + strings.ReplaceAll(normalize(r.sub(n, false)), "\n", "\n> "))
+		r.breaks(2)
+	default:
+		if _, ok := blocks[n.DataAtom]; ok {
+			r.breaks(2)
+			r.children(n)
+			r.breaks(2)
+			return
+		}
+		r.children(n)
+	}
+}
+
+// list renders an ordered or unordered list.
+func (r *renderer) list(n *html.Node) {
+	r.breaks(2 - min(len(r.lists), 1))
+	r.lists = append(r.lists, list{ordered: n.DataAtom == atom.Ol})
+	r.children(n)
+	r.lists = r.lists[:len(r.lists)-1]
+	r.breaks(2 - min(len(r.lists), 1))
+}
+
+// item renders a list item with the marker of the innermost list.
+func (r *renderer) item(n *html.Node) {
+	marker := "- "
+	if depth := len(r.lists); depth > 0 {
+		current := &r.lists[depth-1]
+		current.index++
+		if current.ordered {
+			marker = fmt.Sprintf("%d. ", current.index)
+		}
+		marker = strings.Repeat("  ", depth-1) + marker
+	}
+
+	r.breaks(1)
+	r.write(marker)
+	r.marker = true
+	r.children(n)
+	r.marker = false
+	// Paragraphs inside items do not separate the items by an empty line.
+	r.pending = min(r.pending, 1)
+	r.breaks(1)
+}
+
+// link renders a link, links whose text is the target itself are rendered as plain text.
+func (r *renderer) link(n *html.Node) {
+	text := r.inline(n)
+	href, _ := attr(n, "href")
+	if label := strings.TrimSpace(text); href == "" || label == "" || label == href {
+		r.text(text)
+		return
+	}
+	r.wrap(text, "[", "]("+href+")")
+}
+
+// wrap renders inline text between the given markers, keeping surrounding whitespace outside of them.
+// A single marker is used on both sides.
+func (r *renderer) wrap(text string, markers ...string) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		r.text(text)
+		return
+	}
+
+	open, closing := markers[0], markers[len(markers)-1]
+	if strings.TrimLeftFunc(text, isSpace) != text {
+		r.text(" ")
+	}
+	r.write(open + trimmed + closing)
+	if strings.TrimRightFunc(text, isSpace) != text {
+		r.text(" ")
+	}
+}
+
+// inline renders the children of a node on their own and returns the result.
+func (r *renderer) inline(n *html.Node) string {
+	return r.sub(n, r.pre)
+}
+
+// sub renders the children of a node with a fresh renderer and returns the result.
+func (r *renderer) sub(n *html.Node, pre bool) string {
+	s := &renderer{pre: pre}
+	s.children(n)
+	return s.b.String()
+}
+
+// text renders text, collapsing whitespace outside of preformatted text.
+func (r *renderer) text(s string) {
+	if !r.pre {
+		s = spaces.ReplaceAllString(s, " ")
+		if r.atLineStart() {
+			s = strings.TrimLeft(s, " ")
+		}
+	}
+	r.write(s)
+}
+
+// write renders content, preceded by the pending line breaks.
+func (r *renderer) write(s string) {
+	if s == "" {
+		return
+	}
+	if r.pending > 0 {
+		r.b.WriteString(strings.Repeat("\n", r.pending))
+		r.pending = 0
+	}
+	r.b.WriteString(s)
+	r.marker = false
+}
+
+// breaks requests at least n line breaks before the next content.
+func (r *renderer) breaks(n int) {
+	if r.b.Len() > 0 && !r.marker {
+		r.pending = max(r.pending, n)
+	}
+}
+
+// atLineStart reports whether the next content starts a line or follows a space.
+func (r *renderer) atLineStart() bool {
+	if r.pending > 0 || r.b.Len() == 0 {
+		return true
+	}
+	s := r.b.String()
+	last := s[len(s)-1]
+	return last == '\n' || last == ' '
+}
+
+// normalize trims trailing whitespace of every line and collapses runs of empty lines.
+func normalize(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// isSpace reports whether the rune is whitespace collapsed in text.
+func isSpace(c rune) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,232 @@
+package sanitize
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowed maps the elements kept by Sanitize to the attributes kept on them.
+var allowed = map[atom.Atom][]string{
+	atom.P:          nil,
+	atom.Br:         nil,
+	atom.Hr:         nil,
+	atom.Ul:         nil,
+	atom.Ol:         nil,
+	atom.Li:         nil,
+	atom.Strong:     nil,
+	atom.B:          nil,
+	atom.Em:         nil,
+	atom.I:          nil,
+	atom.H1:         nil,
+	atom.H2:         nil,
+	atom.H3:         nil,
+	atom.H4:         nil,
+	atom.H5:         nil,
+	atom.H6:         nil,
+	atom.Blockquote: nil,
+	atom.Pre:        nil,
+	atom.Code:       nil,
+	atom.A:          {"href"},
+}
+
+// dropped lists the elements removed together with their content.
+var dropped = map[atom.Atom]struct{}{
+	atom.Head:     {},
+	atom.Title:    {},
+	atom.Meta:     {},
+	atom.Link:     {},
+	atom.Script:   {},
+	atom.Style:    {},
+	atom.Noscript: {},
+	atom.Template: {},
+	atom.Iframe:   {},
+	atom.Object:   {},
+	atom.Embed:    {},
+	atom.Img:      {},
+	atom.Picture:  {},
+	atom.Video:    {},
+	atom.Audio:    {},
+	atom.Svg:      {},
+	atom.Canvas:   {},
+	atom.Form:     {},
+	atom.Input:    {},
+	atom.Button:   {},
+	atom.Select:   {},
+	atom.Textarea: {},
+}
+
+// blocks lists the elements that start a new block of text.
+var blocks = map[atom.Atom]struct{}{
+	atom.Address: {}, atom.Article: {}, atom.Aside: {}, atom.Blockquote: {}, atom.Dd: {}, atom.Div: {},
+	atom.Dl: {}, atom.Dt: {}, atom.Figcaption: {}, atom.Figure: {}, atom.Footer: {}, atom.H1: {},
+	atom.H2: {}, atom.H3: {}, atom.H4: {}, atom.H5: {}, atom.H6: {}, atom.Header: {}, atom.Hr: {},
+	atom.Li: {}, atom.Main: {}, atom.Nav: {}, atom.Ol: {}, atom.P: {}, atom.Pre: {}, atom.Section: {},
+	atom.Table: {}, atom.Tbody: {}, atom.Td: {}, atom.Tfoot: {}, atom.Th: {}, atom.Thead: {}, atom.Tr: {},
+	atom.Ul: {},
+}
+
+// voids lists the allowed elements that have no content.
+var voids = map[atom.Atom]struct{}{
+	atom.Br: {},
+	atom.Hr: {},
+}
+
+// schemes lists the URL schemes links may point to.
+var schemes = map[string]struct{}{
+	"http":   {},
+	"https":  {},
+	"mailto": {},
+}
+
+// Sanitize returns the HTML fragment reduced to an allowlist of formatting elements.
+// Scripts, styles, media, tracking pixels, forms and hidden elements are removed with their content,
+// every attribute other than safe link targets is stripped, and other elements are unwrapped.
+// Block containers holding only inline content, such as a div with text, are turned into paragraphs.
+func Sanitize(fragment string) string {
+	nodes, err := parseFragment(fragment)
+	if err != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		clean(&b, n)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// parseFragment parses an HTML fragment in the context of a body element.
+func parseFragment(fragment string) ([]*html.Node, error) {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	return html.ParseFragment(strings.NewReader(fragment), context)
+}
+
+// clean writes the sanitised form of the node to b.
+func clean(b *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		text := n.Data
+		if !inPre(n) {
+			text = spaces.ReplaceAllString(text, " ")
+		}
+		b.WriteString(html.EscapeString(text))
+	case html.DocumentNode:
+		cleanChildren(b, n)
+	case html.ElementNode:
+		cleanElement(b, n)
+	}
+}
+
+// cleanChildren writes the sanitised form of the children of the node to b.
+func cleanChildren(b *strings.Builder, n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		clean(b, c)
+	}
+}
+
+// cleanElement writes the sanitised form of the element to b.
+func cleanElement(b *strings.Builder, n *html.Node) {
+	if _, ok := dropped[n.DataAtom]; ok || isHidden(n) {
+		return
+	}
+
+	tag, attrs := n.DataAtom.String(), allowedAttrs(n)
+	if _, ok := allowed[n.DataAtom]; !ok {
+		if _, ok = blocks[n.DataAtom]; !ok || hasBlockChild(n) {
+			cleanChildren(b, n)
+			return
+		}
+		tag = atom.P.String()
+	}
+	if n.DataAtom == atom.A && attrs == "" {
+		// Links without a safe target are reduced to their text.
+		cleanChildren(b, n)
+		return
+	}
+
+	if _, ok := voids[n.DataAtom]; ok {
+		b.WriteString("<" + tag + ">")
+		return
+	}
+
+	var inner strings.Builder
+	cleanChildren(&inner, n)
+	if strings.TrimSpace(inner.String()) == "" {
+		// Empty elements are dropped, their whitespace is kept to separate the surrounding words.
+		b.WriteString(inner.String())
+		return
+	}
+	b.WriteString("<" + tag + attrs + ">" + inner.String() + "</" + tag + ">")
+}
+
+// allowedAttrs renders the attributes of the element that are kept.
+func allowedAttrs(n *html.Node) string {
+	var b strings.Builder
+	for _, name := range allowed[n.DataAtom] {
+		value, ok := attr(n, name)
+		if !ok || (name == "href" && !isSafeURL(value)) {
+			continue
+		}
+		b.WriteString(" " + name + `="` + html.EscapeString(value) + `"`)
+	}
+	return b.String()
+}
+
+// attr returns the value of the named attribute of the node.
+func attr(n *html.Node, name string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && strings.EqualFold(a.Key, name) {
+			return strings.TrimSpace(a.Val), true
+		}
+	}
+	return "", false
+}
+
+// isSafeURL reports whether the link target is an absolute URL with an allowed scheme.
+func isSafeURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	_, ok := schemes[strings.ToLower(u.Scheme)]
+	return ok
+}
+
+// isHidden reports whether the element is not displayed to readers.
+func isHidden(n *html.Node) bool {
+	if _, ok := attr(n, "hidden"); ok {
+		return true
+	}
+	if value, _ := attr(n, "aria-hidden"); strings.EqualFold(value, "true") {
+		return true
+	}
+	style, _ := attr(n, "style")
+	style = strings.ToLower(strings.ReplaceAll(style, " ", ""))
+	return strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden")
+}
+
+// hasBlockChild reports whether any descendant of the element starts a new block of text.
+func hasBlockChild(n *html.Node) bool {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			continue
+		}
+		if _, ok := blocks[c.DataAtom]; ok || hasBlockChild(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// inPre reports whether the node is inside preformatted text.
+func inPre(n *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.DataAtom == atom.Pre {
+			return true
+		}
+	}
+	return false
+}
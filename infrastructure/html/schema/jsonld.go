@@ -0,0 +1,182 @@
+package schema
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// fromJSONLD returns the first JobPosting found in a JSON-LD document, including those in a @graph.
+func fromJSONLD(data string) *JobPosting {
+	var document any
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return nil
+	}
+
+	node := findPosting(document)
+	if node == nil {
+		return nil
+	}
+
+	posting := &JobPosting{
+		Identifier:     identifier(node["identifier"]),
+		Title:          text(node["title"]),
+		Company:        text(node["hiringOrganization"]),
+		DatePosted:     text(node["datePosted"]),
+		Description:    text(node["description"]),
+		EmploymentType: texts(node["employmentType"]),
+		Salary:         baseSalary(node["baseSalary"]),
+	}
+	for _, place := range list(node["jobLocation"]) {
+		if location := placeName(place); location != "" {
+			posting.Locations = append(posting.Locations, location)
+		}
+	}
+	for _, kind := range texts(node["jobLocationType"]) {
+		if strings.EqualFold(kind, "TELECOMMUTE") {
+			posting.Remote = true
+		}
+	}
+	return posting
+}
+
+// findPosting walks the document and returns the first object typed as JobPosting.
+func findPosting(v any) map[string]any {
+	switch node := v.(type) {
+	case []any:
+		for _, item := range node {
+			if posting := findPosting(item); posting != nil {
+				return posting
+			}
+		}
+	case map[string]any:
+		for _, kind := range texts(node["@type"]) {
+			if kind == "JobPosting" {
+				return node
+			}
+		}
+		return findPosting(node["@graph"])
+	}
+	return nil
+}
+
+// identifier returns the value of an identifier, either plain text or a PropertyValue.
+func identifier(v any) string {
+	if value, ok := first(v).(map[string]any); ok {
+		return text(value["value"])
+	}
+	return text(v)
+}
+
+// placeName formats a Place, whose address is either a PostalAddress or plain text.
+func placeName(v any) string {
+	place, ok := v.(map[string]any)
+	if !ok {
+		return text(v)
+	}
+
+	address, ok := place["address"].(map[string]any)
+	if !ok {
+		if name := text(place["address"]); name != "" {
+			return name
+		}
+		return text(place["name"])
+	}
+	return formatAddress(text(address["addressLocality"]), text(address["addressRegion"]),
+		text(address["addressCountry"]))
+}
+
+// baseSalary converts a MonetaryAmount, whose value is either a number or a QuantitativeValue.
+func baseSalary(v any) *Salary {
+	amount, ok := first(v).(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	s := &Salary{Currency: text(amount["currency"])}
+	switch value := amount["value"].(type) {
+	case map[string]any:
+		s.Min, s.Max = number(value["minValue"]), number(value["maxValue"])
+		if single := number(value["value"]); single > 0 {
+			s.Min, s.Max = single, single
+		}
+		s.Unit = text(value["unitText"])
+	default:
+		s.Min = number(value)
+		s.Max = s.Min
+	}
+	if s.Unit == "" {
+		s.Unit = text(amount["unitText"])
+	}
+
+	switch {
+	case s.Min == 0 && s.Max == 0:
+		return nil
+	case s.Min == 0:
+		s.Min = s.Max
+	case s.Max == 0:
+		s.Max = s.Min
+	}
+	return s
+}
+
+// list wraps a single value into a slice, leaving slices untouched.
+func list(v any) []any {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return value
+	default:
+		return []any{value}
+	}
+}
+
+// first returns the first item of a slice, or the value itself.
+func first(v any) any {
+	if items := list(v); len(items) > 0 {
+		return items[0]
+	}
+	return nil
+}
+
+// text returns the textual value of v: strings as is, numbers formatted, objects by their name.
+func text(v any) string {
+	switch value := first(v).(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case map[string]any:
+		return text(value["name"])
+	default:
+		return ""
+	}
+}
+
+// texts returns the textual values of every item of v.
+func texts(v any) []string {
+	var values []string
+	for _, item := range list(v) {
+		if value := text(item); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// number returns the numeric value of v, parsing strings such as "5000" or "5000.50".
+func number(v any) float64 {
+	switch value := v.(type) {
+	case float64:
+		return value
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return n
+	default:
+		return 0
+	}
+}
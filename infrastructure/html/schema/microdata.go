@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// fromMicrodata returns the first JobPosting announced with microdata attributes.
+func fromMicrodata(doc *goquery.Document) *JobPosting {
+	scope := doc.Find("[itemscope][itemtype*='schema.org/JobPosting']").First()
+	if scope.Length() == 0 {
+		return nil
+	}
+
+	posting := &JobPosting{
+		Identifier: property(scope, "identifier"),
+		Title:      property(scope, "title"),
+		Company:    property(scope, "hiringOrganization"),
+		DatePosted: property(scope, "datePosted"),
+	}
+	if organization := scope.Find("[itemprop='hiringOrganization'][itemscope]").First(); organization.Length() > 0 {
+		posting.Company = property(organization, "name")
+	}
+	if id := scope.Find("[itemprop='identifier'][itemscope]").First(); id.Length() > 0 {
+		posting.Identifier = property(id, "value")
+	}
+	if description := scope.Find("[itemprop='description']").First(); description.Length() > 0 {
+		posting.Description, _ = description.Html()
+	}
+	scope.Find("[itemprop='employmentType']").Each(func(_ int, s *goquery.Selection) {
+		if v := value(s); v != "" {
+			posting.EmploymentType = append(posting.EmploymentType, v)
+		}
+	})
+	scope.Find("[itemprop='jobLocation']").Each(func(_ int, s *goquery.Selection) {
+		location := formatAddress(property(s, "addressLocality"), property(s, "addressRegion"),
+			property(s, "addressCountry"))
+		if location == "" {
+			location = value(s)
+		}
+		if location != "" {
+			posting.Locations = append(posting.Locations, location)
+		}
+	})
+	if strings.EqualFold(property(scope, "jobLocationType"), "TELECOMMUTE") {
+		posting.Remote = true
+	}
+	if amount := scope.Find("[itemprop='baseSalary']").First(); amount.Length() > 0 {
+		posting.Salary = baseSalary(map[string]any{
+			"currency": property(amount, "currency"),
+			"value": map[string]any{
+				"value":    property(amount, "value"),
+				"minValue": property(amount, "minValue"),
+				"maxValue": property(amount, "maxValue"),
+				"unitText": property(amount, "unitText"),
+			},
+		})
+	}
+	return posting
+}
+
+// property returns the value of the first element within s announcing the named property.
+func property(s *goquery.Selection, name string) string {
+	return value(s.Find("[itemprop='" + name + "']").First())
+}
+
+// value returns the microdata value of an element: its content, datetime or href attribute, or else its text.
+func value(s *goquery.Selection) string {
+	if s.Length() == 0 {
+		return ""
+	}
+	for _, attr := range []string{"content", "datetime", "href"} {
+		if v, ok := s.Attr(attr); ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return strings.Join(strings.Fields(s.Text()), " ")
+}
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"application/url/processor/dto"
+	"domain/html"
+	"domain/vacancy/entity"
+	"errors"
+	"fmt"
+	stdHtml "html"
+	"infrastructure/date"
+	"infrastructure/html/sanitize"
+	"infrastructure/salary"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// version is the version of the schema.org extraction rules.
+const version = "5"
+
+// employmentTypes maps the schema.org employment types to the kind of contract.
+var employmentTypes = map[string]entity.EmploymentType{
+	"FULL_TIME":  entity.EmploymentPermanent,
+	"PART_TIME":  entity.EmploymentPartTime,
+	"CONTRACTOR": entity.EmploymentContract,
+	"TEMPORARY":  entity.EmploymentContract,
+}
+
+// ErrNoJobPosting is returned by a parser without fallback when the page announces no JobPosting.
+var ErrNoJobPosting = errors.New("no schema.org JobPosting found")
+
+// Parser extracts vacancy details from the schema.org JobPosting embedded in a page as JSON-LD or microdata.
+// Fields the JobPosting lacks are taken from the fallback parser, if any.
+type Parser struct {
+	fallback html.Parser // The source-specific parser filling in missing fields, may be nil.
+}
+
+// NewParser creates and returns a new Parser instance wrapping the given fallback parser, which may be nil.
+func NewParser(fallback html.Parser) *Parser {
+	return &Parser{fallback: fallback}
+}
+
+// Name returns the name of the fallback parser, so stored vacancies keep their parser name.
+func (p *Parser) Name() string {
+	if p.fallback == nil {
+		return "schema"
+	}
+	return p.fallback.Name()
+}
+
+// Version returns the version of the extraction rules, combined with the fallback parser version.
+func (p *Parser) Version() string {
+	if p.fallback == nil {
+		return version
+	}
+	return fmt.Sprintf("%s+schema.%s", p.fallback.Version(), version)
+}
+
+// Parse parses the provided raw HTML content and extracts vacancy details.
+func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
+	return p.ParseAt(htmlContent, time.Now())
+}
+
+// ParseAt parses the provided raw HTML content fetched at the given time and extracts vacancy details.
+func (p *Parser) ParseAt(htmlContent string, fetchedAt time.Time) (*dto.Vacancy, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
+	if err != nil {
+		return nil, fmt.Errorf("load HTML document: %w", err)
+	}
+	posting := Extract(doc)
+
+	var v *dto.Vacancy
+	switch {
+	case p.fallback != nil:
+		if v, err = p.fallback.ParseAt(htmlContent, fetchedAt); err != nil {
+			return nil, err
+		}
+	case posting == nil:
+		return nil, ErrNoJobPosting
+	default:
+		v = dto.GetVacancy()
+		v.Title = "Unknown Title"
+		v.Company = "Unknown Company"
+		v.PostedAt = fetchedAt
+		v.PostedAtConfidence = entity.DateConfidenceNone
+	}
+
+	if posting != nil {
+		apply(v, posting, fetchedAt)
+	}
+	return v, nil
+}
+
+// apply overwrites the vacancy fields announced by the JobPosting.
+func apply(v *dto.Vacancy, posting *JobPosting, fetchedAt time.Time) {
+	if posting.Identifier != "" {
+		v.ExternalID = posting.Identifier
+	}
+	if posting.Title != "" {
+		v.Title = posting.Title
+	}
+	if posting.Company != "" {
+		v.Company = posting.Company
+	}
+	if location := posting.Location(); location != "" {
+		v.Location = location
+	}
+	if descriptionHTML := sanitize.Sanitize(unescape(posting.Description)); descriptionHTML != "" {
+		v.DescriptionHTML = descriptionHTML
+		v.Description = sanitize.Markdown(descriptionHTML)
+	}
+	for _, kind := range posting.EmploymentType {
+		kind = strings.ToUpper(kind)
+		if kind == "INTERN" {
+			v.Seniority = entity.SeniorityIntern
+		}
+		if employment, ok := employmentTypes[kind]; ok && v.EmploymentType == "" {
+			v.EmploymentType = employment
+		}
+	}
+	if posting.Remote {
+		v.WorkMode = entity.WorkModeRemote
+	}
+	if posting.Salary != nil {
+		v.Salary = &entity.Salary{
+			Min:      posting.Salary.Min,
+			Max:      posting.Salary.Max,
+			Currency: strings.ToUpper(posting.Salary.Currency),
+			Period:   salary.PeriodOf(posting.Salary.Unit, posting.Salary.Max),
+		}
+	}
+	if posted, err := date.ParseAbsolute(posting.DatePosted, fetchedAt); err == nil &&
+		v.PostedAtConfidence.Rank() < entity.DateConfidenceHigh.Rank() {
+		v.PostedAt = posted
+		v.PostedAtConfidence = entity.DateConfidenceHigh
+	}
+}
+
+// unescape decodes descriptions whose markup was HTML-escaped a second time, as some boards do in JSON-LD.
+func unescape(description string) string {
+	if strings.Contains(description, "<") || !strings.Contains(description, "&lt;") {
+		return description
+	}
+	return stdHtml.UnescapeString(description)
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// JobPosting holds the fields of a schema.org JobPosting embedded in a page.
+type JobPosting struct {
+	Identifier     string   // The identifier the board assigned to the job.
+	Title          string   // The title of the job.
+	Company        string   // The name of the hiring organization.
+	DatePosted     string   // The raw publication date, parsed by the caller.
+	Locations      []string // The job locations formatted as "Locality, Region, Country".
+	Remote         bool     // Whether the job is announced as telecommute.
+	Description    string   // The raw description, which may contain HTML.
+	EmploymentType []string // The employment types, e.g. FULL_TIME or CONTRACTOR.
+	Salary         *Salary  // The base salary, nil when not announced.
+}
+
+// Salary holds the base salary of a JobPosting.
+type Salary struct {
+	Currency string  // The ISO 4217 currency code.
+	Min      float64 // The lower bound, equal to Max for a single value.
+	Max      float64 // The upper bound, equal to Min for a single value.
+	Unit     string  // The period the amount is paid for, e.g. HOUR, MONTH or YEAR.
+}
+
+// Location joins the job locations, announcing telecommute jobs as remote.
+func (p *JobPosting) Location() string {
+	locations := p.Locations
+	if p.Remote {
+		locations = append([]string{"Remote"}, locations...)
+	}
+	return strings.Join(locations, "; ")
+}
+
+// Extract returns the JobPosting embedded in the document, preferring JSON-LD over microdata.
+// Returns nil when the document announces no JobPosting.
+func Extract(doc *goquery.Document) *JobPosting {
+	var posting *JobPosting
+	doc.Find("script[type='application/ld+json']").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		posting = fromJSONLD(s.Text())
+		return posting == nil
+	})
+	if posting != nil {
+		return posting
+	}
+	return fromMicrodata(doc)
+}
+
+// formatAddress joins the non-empty address parts, skipping parts repeating the previous one.
+func formatAddress(parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" || (len(kept) > 0 && strings.EqualFold(kept[len(kept)-1], part)) {
+			continue
+		}
+		kept = append(kept, part)
+	}
+	return strings.Join(kept, ", ")
+}
@@ -2,9 +2,13 @@ package alfa
 
 import (
 	"context"
+	"domain/html"
+	"domain/pipeline"
 	"fmt"
+	infraHtml "infrastructure/html"
 	"io"
 	"net/http"
+	"time"
 )
 
 // HttpClient defines an interface for making HTTP requests and managing connections.
@@ -27,8 +31,10 @@ func NewFetcher(httpClient HttpClient, maxBodySize int64) *Fetcher {
 	return &Fetcher{httpClient: httpClient, maxBodySize: maxBodySize}
 }
 
-// Fetch sends an HTTP GET request to the specified URL and returns the response body as a string.
-func (f *Fetcher) Fetch(ctx context.Context, url string) (result string, err error) {
+// Fetch sends an HTTP GET request to the specified URL and returns the response with its body as a string.
+// Failures are returned as pipeline errors, telling network problems, unexpected statuses, blocks and closed
+// postings apart.
+func (f *Fetcher) Fetch(ctx context.Context, url string) (result *html.Response, err error) {
 	var (
 		request  *http.Request
 		response *http.Response
@@ -36,27 +42,34 @@ func (f *Fetcher) Fetch(ctx context.Context, url string) (result string, err err
 	)
 
 	if request, err = http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody); err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 
 	if response, err = f.httpClient.Do(request); err != nil {
-		return "", fmt.Errorf("do request: %w", err)
+		return nil, pipeline.Network(fmt.Errorf("do request: %w", err))
 	}
 	defer func() {
-		if err = response.Body.Close(); err != nil {
-			fmt.Printf("close response body: %v", err)
+		if cErr := response.Body.Close(); cErr != nil {
+			fmt.Printf("close response body: %v", cErr)
 		}
 	}()
 	defer f.httpClient.CloseIdleConnections()
 
-	if response.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("http status code: %d", response.StatusCode)
-	}
-
 	limitedReader := io.LimitReader(response.Body, f.maxBodySize)
 	if body, err = io.ReadAll(limitedReader); err != nil {
-		return "", fmt.Errorf("read response body: %w", err)
+		return nil, pipeline.Network(fmt.Errorf("read response body: %w", err))
+	}
+
+	result = &html.Response{
+		Body:       string(body),
+		FinalURL:   response.Request.URL.String(),
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		FetchedAt:  time.Now(),
+	}
+	if err = infraHtml.CheckPosting(url, response.StatusCode, result.FinalURL, body); err != nil {
+		return result, fmt.Errorf("check response: %w", err)
 	}
 
-	return string(body), nil
+	return result, nil
 }
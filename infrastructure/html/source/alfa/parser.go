@@ -3,20 +3,42 @@ package alfa
 import (
 	"application/url/processor/dto"
 	"fmt"
+	infraHtml "infrastructure/html"
+	"infrastructure/html/sanitize"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+// descriptionSelectors locate the container of the job description, the first match is used.
+var descriptionSelectors = []string{"div.job-description", "[itemprop='description']", "div.description"}
+
+// locationSelectors locate the element holding the job location, the first non-empty match is used.
+var locationSelectors = []string{".job-location", "[itemprop='jobLocation']", ".location"}
+
+// dateSelectors locate the visible posting date, every match is a candidate.
+var dateSelectors = []string{".job-date", ".posted-date", "[class*='posted']"}
+
 // Parser is responsible for extracting vacancy details from raw HTML content.
 type Parser struct{}
 
 // NewParser creates and returns a new Parser instance.
 func NewParser() *Parser { return &Parser{} }
 
+// Name returns the name identifying the parser.
+func (p *Parser) Name() string { return "alfa" }
+
+// Version returns the version of the extraction rules.
+func (p *Parser) Version() string { return "4" }
+
 // Parse parses the provided raw HTML content and extracts vacancy details.
 func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
+	return p.ParseAt(htmlContent, time.Now())
+}
+
+// ParseAt parses the provided raw HTML content fetched at the given time and extracts vacancy details.
+func (p *Parser) ParseAt(htmlContent string, fetchedAt time.Time) (*dto.Vacancy, error) {
 	// Load the HTML document using goquery
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
 	if err != nil {
@@ -35,17 +57,60 @@ func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
 		company = "Unknown Company"
 	}
 
-	// Extract the job description
-	description := "-" // todo: improve
-	location := "-"
+	// Extract the job description, sanitised and converted to Markdown
+	descriptionHTML := sanitize.Sanitize(extractDescription(doc))
+	description := sanitize.Markdown(descriptionHTML)
+	location := extractLocation(doc)
+
+	// Extract the posting date
+	postedAt, confidence := infraHtml.PostedAt(doc, fetchedAt, extractDates(doc)...)
 
 	// Populate the vacancy DTO with extracted data
 	v := dto.GetVacancy()
 	v.Title = title
 	v.Company = company
 	v.Description = description
+	v.DescriptionHTML = descriptionHTML
 	v.Location = location
-	v.PostedAt = time.Now()
+	v.PostedAt = postedAt
+	v.PostedAtConfidence = confidence
 
 	return v, nil
 }
+
+// extractDescription extracts the raw HTML of the job description from the HTML document.
+func extractDescription(doc *goquery.Document) string {
+	for _, selector := range descriptionSelectors {
+		if selection := doc.Find(selector).First(); selection.Length() > 0 {
+			description, err := selection.Html()
+			if err != nil {
+				return ""
+			}
+			return description
+		}
+	}
+	return ""
+}
+
+// extractLocation extracts the job location from the HTML document, empty if the page does not announce one.
+func extractLocation(doc *goquery.Document) string {
+	for _, selector := range locationSelectors {
+		if location := strings.Join(strings.Fields(doc.Find(selector).First().Text()), " "); location != "" {
+			return location
+		}
+	}
+	return ""
+}
+
+// extractDates extracts the texts of the elements that may hold the posting date.
+func extractDates(doc *goquery.Document) []string {
+	var dates []string
+	for _, selector := range dateSelectors {
+		doc.Find(selector).Each(func(_ int, s *goquery.Selection) {
+			if text := strings.TrimSpace(s.Text()); text != "" {
+				dates = append(dates, text)
+			}
+		})
+	}
+	return dates
+}
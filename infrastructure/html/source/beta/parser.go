@@ -3,12 +3,17 @@ package beta
 import (
 	"application/url/processor/dto"
 	"fmt"
+	infraHtml "infrastructure/html"
+	"infrastructure/html/sanitize"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+// dateLabels are the labels of the field holding the posting date.
+var dateLabels = []string{"Published", "Posted", "Added"}
+
 // Parser is responsible for extracting vacancy details from raw HTML content.
 type Parser struct{}
 
@@ -17,8 +22,19 @@ func NewParser() *Parser {
 	return &Parser{}
 }
 
+// Name returns the name identifying the parser.
+func (p *Parser) Name() string { return "beta" }
+
+// Version returns the version of the extraction rules.
+func (p *Parser) Version() string { return "4" }
+
 // Parse parses the provided raw HTML content and extracts vacancy details.
 func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
+	return p.ParseAt(htmlContent, time.Now())
+}
+
+// ParseAt parses the provided raw HTML content fetched at the given time and extracts vacancy details.
+func (p *Parser) ParseAt(htmlContent string, fetchedAt time.Time) (*dto.Vacancy, error) {
 	// Load the HTML document using goquery
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
 	if err != nil {
@@ -37,26 +53,43 @@ func (p *Parser) Parse(htmlContent string) (*dto.Vacancy, error) {
 		company = "Unknown Company"
 	}
 
-	// Extract the job description
-	_ = extractDescription(doc)
-	description := "-" // todo: improve
+	// Extract the job description, sanitised and converted to Markdown
+	descriptionHTML := sanitize.Sanitize(extractDescription(doc))
+	description := sanitize.Markdown(descriptionHTML)
 
 	location := extractLocation(doc)
 
+	// Extract the posting date
+	var dates []string
+	for _, label := range dateLabels {
+		if text := extractField(doc, label); text != "" {
+			dates = append(dates, text)
+		}
+	}
+	postedAt, confidence := infraHtml.PostedAt(doc, fetchedAt, dates...)
+
 	// Populate the vacancy DTO with extracted data
 	v := dto.GetVacancy()
 	v.Title = title
 	v.Company = company
 	v.Description = description
+	v.DescriptionHTML = descriptionHTML
 	v.Location = location
-	v.PostedAt = time.Now()
+	v.PostedAt = postedAt
+	v.PostedAtConfidence = confidence
 
 	return v, nil
 }
 
-// extractLocation extracts the location from the HTML document.
+// extractLocation extracts the location from the HTML document, empty if the page does not announce one.
 func extractLocation(doc *goquery.Document) string {
-	var location string
+	return extractField(doc, "Operating mode")
+}
+
+// extractField extracts the value of a labelled field from the HTML document.
+// Fields are rendered as a <div> with two child <div> elements, the label and the value.
+func extractField(doc *goquery.Document, label string) string {
+	var value string
 
 	// Find all <div> elements with the "MuiBox-root" class
 	doc.Find("div.MuiBox-root").EachWithBreak(func(i int, s *goquery.Selection) bool {
@@ -65,24 +98,21 @@ func extractLocation(doc *goquery.Document) string {
 
 		// Ensure the <div> has exactly two child <div> elements
 		if childDivs.Length() == 2 {
-			// Check if the first child <div> has the text "Operating mode"
+			// Check if the first child <div> has the label text
 			firstChild := childDivs.Eq(0)
-			if strings.TrimSpace(firstChild.Text()) != "Operating mode" {
-				return true // Continue if the text is not "Operating mode"
+			if strings.TrimSpace(firstChild.Text()) != label {
+				return true // Continue if the text is not the label
 			}
 
 			// Extract the text from the second child <div>
 			secondChild := childDivs.Eq(1)
-			location = strings.TrimSpace(secondChild.Text())
+			value = strings.TrimSpace(secondChild.Text())
 			return false // Break the loop as we've found the desired element
 		}
 		return true // Continue loop if conditions are not met
 	})
 
-	if location == "" {
-		location = "Unknown Location" // Default value if not found
-	}
-	return location
+	return value
 }
 
 // extractDescription extracts the job description from the HTML document.
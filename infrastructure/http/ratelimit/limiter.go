@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Policy describes how politely a single host is crawled.
+type Policy struct {
+	RequestsPerMinute float64       // RequestsPerMinute is the sustained request rate, zero or less means unlimited.
+	Burst             int           // Burst is the number of requests that may be sent back to back.
+	MinDelay          time.Duration // MinDelay is the minimum time between two requests to the host.
+	RespectCrawlDelay bool          // RespectCrawlDelay raises MinDelay to the Crawl-delay announced in robots.txt.
+}
+
+// bucket is the token bucket of a single host.
+type bucket struct {
+	policy     Policy        // policy is the policy the bucket enforces.
+	tokens     float64       // tokens is the number of requests currently available.
+	updated    time.Time     // updated is the time tokens was last refilled.
+	last       time.Time     // last is the time the most recent request was scheduled.
+	crawlDelay time.Duration // crawlDelay is the Crawl-delay announced by the host.
+}
+
+// Limiter throttles requests per host using a token bucket for each of them.
+type Limiter struct {
+	mu       sync.Mutex
+	fallback Policy             // fallback is the policy of hosts without an explicit one.
+	policies map[string]Policy  // policies maps normalised host names to their policy.
+	buckets  map[string]*bucket // buckets maps normalised host names to their state.
+	now      func() time.Time   // now returns the current time.
+}
+
+// NewLimiter creates a new Limiter applying the fallback policy to hosts without an explicit one.
+func NewLimiter(fallback Policy) *Limiter {
+	return &Limiter{
+		fallback: fallback,
+		policies: make(map[string]Policy),
+		buckets:  make(map[string]*bucket),
+		now:      time.Now,
+	}
+}
+
+// SetPolicy assigns a policy to the given host, replacing the previous one.
+func (l *Limiter) SetPolicy(host string, policy Policy) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := normalizeHost(host)
+	l.policies[key] = policy
+	if b, ok := l.buckets[key]; ok {
+		b.policy = policy
+	}
+}
+
+// Policy returns the policy applied to the given host.
+func (l *Limiter) Policy(host string) Policy {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.bucket(normalizeHost(host)).policy
+}
+
+// SetCrawlDelay records the Crawl-delay announced by the given host.
+func (l *Limiter) SetCrawlDelay(host string, delay time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bucket(normalizeHost(host)).crawlDelay = delay
+}
+
+// Wait blocks until a request to the given host is allowed or the context is done.
+func (l *Limiter) Wait(ctx context.Context, host string) error {
+	delay := l.reserve(normalizeHost(host))
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait for %s: %w", host, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve schedules the next request to the host and returns how long the caller has to wait for it.
+func (l *Limiter) reserve(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var (
+		b   = l.bucket(key)
+		now = l.now()
+		at  = now
+	)
+
+	// Token bucket.
+	if b.policy.RequestsPerMinute > 0 {
+		interval := time.Duration(float64(time.Minute) / b.policy.RequestsPerMinute)
+		b.tokens = min(b.tokens+float64(now.Sub(b.updated))/float64(interval), float64(max(b.policy.Burst, 1)))
+		b.updated = now
+		b.tokens--
+		if b.tokens < 0 {
+			at = now.Add(time.Duration(-b.tokens * float64(interval)))
+		}
+	}
+
+	// Minimum spacing between two consecutive requests.
+	minDelay := b.policy.MinDelay
+	if b.policy.RespectCrawlDelay {
+		minDelay = max(minDelay, b.crawlDelay)
+	}
+	if !b.last.IsZero() && at.Before(b.last.Add(minDelay)) {
+		at = b.last.Add(minDelay)
+	}
+	b.last = at
+
+	return at.Sub(now)
+}
+
+// bucket returns the state of the host, creating it on first use. The caller must hold the lock.
+func (l *Limiter) bucket(key string) *bucket {
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+
+	policy := l.policyOf(key)
+	b := &bucket{policy: policy, tokens: float64(max(policy.Burst, 1)), updated: l.now()}
+	l.buckets[key] = b
+	return b
+}
+
+// policyOf returns the policy of the host, inherited from the closest parent domain with an explicit policy,
+// so that the policy of "example.com" also covers "jobs.example.com". The caller must hold the lock.
+func (l *Limiter) policyOf(key string) Policy {
+	host := key
+	for {
+		if policy, ok := l.policies[host]; ok {
+			return policy
+		}
+		// Stop before reaching a bare top-level domain such as "com".
+		_, parent, found := strings.Cut(host, ".")
+		if !found || !strings.Contains(parent, ".") {
+			return l.fallback
+		}
+		host = parent
+	}
+}
+
+// normalizeHost reduces a host to the key its policy is stored under, so that
+// "www.example.com:443" and "example.com" share the same bucket.
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return strings.TrimPrefix(host, "www.")
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseCrawlDelay extracts the Crawl-delay that robots.txt announces for all user agents ("*").
+// It reports false when no such directive is present.
+func ParseCrawlDelay(r io.Reader) (time.Duration, bool) {
+	var (
+		scanner  = bufio.NewScanner(r)
+		wildcard bool // wildcard is set while the current group applies to all user agents.
+		inAgents bool // inAgents is set while consecutive User-agent lines are being read.
+	)
+
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if !inAgents {
+				wildcard = false
+			}
+			inAgents = true
+			wildcard = wildcard || value == "*"
+		case "crawl-delay":
+			inAgents = false
+			if !wildcard {
+				continue
+			}
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil || seconds < 0 {
+				continue
+			}
+			return time.Duration(seconds * float64(time.Second)), true
+		default:
+			inAgents = false
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,152 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxRobotsSize is the number of bytes read from a robots.txt file.
+const maxRobotsSize = 512 * 1024
+
+// Slots caps the number of requests in flight across all hosts.
+type Slots interface {
+	// Acquire blocks until a slot is available or the context is done.
+	Acquire(ctx context.Context) error
+	// Release frees a slot previously taken with Acquire.
+	Release()
+}
+
+// Transport is an http.RoundTripper that throttles requests per host before passing them on.
+type Transport struct {
+	rt      http.RoundTripper     // rt is the underlying round tripper.
+	limiter *Limiter              // limiter decides when a request to a host may be sent.
+	slots   Slots                 // slots caps the requests in flight across hosts (optional).
+	mu      sync.Mutex            // mu guards robots.
+	robots  map[string]*sync.Once // robots ensures robots.txt is looked up once per host.
+}
+
+// NewTransport wraps the given round tripper with the limiter.
+func NewTransport(rt http.RoundTripper, limiter *Limiter) *Transport {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &Transport{rt: rt, limiter: limiter, robots: make(map[string]*sync.Once)}
+}
+
+// SetSlots makes every request take one of the given slots once its host's turn has come, and hold it until
+// its response body is closed. It must be called before the transport is used.
+func (t *Transport) SetSlots(slots Slots) {
+	t.slots = slots
+}
+
+// WrapClient returns a copy of the client whose requests are throttled by the limiter.
+func WrapClient(client *http.Client, limiter *Limiter) *http.Client {
+	return NewTransport(client.Transport, limiter).Wrap(client)
+}
+
+// Wrap returns a copy of the client sending its requests through the transport, so that clients
+// wrapped by the same transport share its throttling and robots.txt lookups.
+func (t *Transport) Wrap(client *http.Client) *http.Client {
+	wrapped := *client
+	wrapped.Transport = t
+	return &wrapped
+}
+
+// RoundTripperFunc is an adapter allowing the use of an ordinary function as an http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// RoundTrip waits for the host's turn and executes the request.
+// The slot is only taken after the wait, so that waiting for a slow host does not hold up requests to others.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path != "/robots.txt" && t.limiter.Policy(req.URL.Host).RespectCrawlDelay {
+		t.loadCrawlDelay(req)
+	}
+
+	if err := t.limiter.Wait(req.Context(), req.URL.Host); err != nil {
+		return nil, err
+	}
+	if t.slots == nil {
+		return t.rt.RoundTrip(req)
+	}
+
+	if err := t.slots.Acquire(req.Context()); err != nil {
+		return nil, fmt.Errorf("acquire request slot: %w", err)
+	}
+	response, err := t.rt.RoundTrip(req)
+	if err != nil || response.Body == nil {
+		t.slots.Release()
+		return response, err
+	}
+	response.Body = &slotBody{ReadCloser: response.Body, release: t.slots.Release}
+	return response, nil
+}
+
+// slotBody is a response body that releases its request slot once it is closed.
+type slotBody struct {
+	io.ReadCloser
+	release func()    // release frees the request slot.
+	once    sync.Once // once ensures the slot is released once.
+}
+
+// Close closes the body and releases the request slot.
+func (b *slotBody) Close() error {
+	defer b.once.Do(b.release)
+	return b.ReadCloser.Close()
+}
+
+// loadCrawlDelay reads the Crawl-delay of the request's host the first time the host is seen.
+func (t *Transport) loadCrawlDelay(req *http.Request) {
+	t.mu.Lock()
+	once, ok := t.robots[req.URL.Host]
+	if !ok {
+		once = &sync.Once{}
+		t.robots[req.URL.Host] = once
+	}
+	t.mu.Unlock()
+
+	once.Do(func() {
+		if err := t.fetchCrawlDelay(req); err != nil {
+			fmt.Printf("[WARN] robots.txt of %s: %v\n", req.URL.Host, err)
+		}
+	})
+}
+
+// fetchCrawlDelay downloads robots.txt of the request's host and records its Crawl-delay.
+func (t *Transport) fetchCrawlDelay(req *http.Request) (err error) {
+	var (
+		robotsURL = *req.URL
+		request   *http.Request
+		response  *http.Response
+	)
+
+	robotsURL.Path, robotsURL.RawPath, robotsURL.RawQuery, robotsURL.Fragment = "/robots.txt", "", "", ""
+	if request, err = http.NewRequestWithContext(req.Context(), http.MethodGet, robotsURL.String(), http.NoBody); err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	request.Header.Set("User-Agent", req.Header.Get("User-Agent"))
+
+	if response, err = t.RoundTrip(request); err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer func() {
+		if cErr := response.Body.Close(); cErr != nil {
+			fmt.Printf("close robots.txt body: %v\n", cErr)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil
+	}
+	if delay, ok := ParseCrawlDelay(io.LimitReader(response.Body, maxRobotsSize)); ok {
+		t.limiter.SetCrawlDelay(req.URL.Host, delay)
+	}
+	return nil
+}
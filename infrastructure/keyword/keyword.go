@@ -0,0 +1,56 @@
+// Package keyword finds which of several lists of words occurs first in a text.
+package keyword
+
+import "strings"
+
+// Keyword maps the words announcing a value to that value.
+type Keyword[T any] struct {
+	Words []string // The lower-case words announcing the value.
+	Value T        // The value announced.
+	Exact bool     // Exact requires every word to end a word, so "intern" is not found in "international".
+}
+
+// Find returns the value of the keyword occurring first in the lower-case text, or the fallback when none occurs.
+func Find[T any](text string, keywords []Keyword[T], fallback T) T {
+	best, value := -1, fallback
+	for _, k := range keywords {
+		for _, word := range k.Words {
+			if i := index(text, word, k.Exact); i >= 0 && (best < 0 || i < best) {
+				best, value = i, k.Value
+			}
+		}
+	}
+	return value
+}
+
+// index returns the index of the first occurrence of word in text that starts a word, so "net" is not found
+// in "internet" or ".net". Exact words and words of up to three bytes must also end a word, longer ones match as
+// prefixes so that "month" finds "monthly".
+func index(text, word string, exact bool) int {
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return -1
+		}
+		start, end := offset+i, offset+i+len(word)
+		if startsWord(text, start, word) && (!exact && len(word) > 3 || end == len(text) || !isWordByte(text[end])) {
+			return start
+		}
+		offset = start + 1
+	}
+}
+
+// startsWord reports whether word, found at start of text, is not preceded by a letter, a digit or a dot.
+// Words starting with punctuation such as "/h" carry their own boundary.
+func startsWord(text string, start int, word string) bool {
+	if start == 0 || !isWordByte(word[0]) {
+		return true
+	}
+	prev := text[start-1]
+	return !isWordByte(prev) && prev != '.'
+}
+
+// isWordByte reports whether b is part of a word: an ASCII letter or digit, or a byte of a multibyte rune.
+func isWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= '0' && b <= '9' || b >= 0x80
+}
@@ -0,0 +1,145 @@
+package language
+
+import (
+	"embed"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Version is the version of the embedded profiles, bump it whenever a profile changes so stored vacancies are
+// detected again.
+const Version = "1"
+
+const (
+	minTrigrams = 20   // Texts with fewer trigrams are too short to be detected.
+	maxTrigrams = 3000 // Only the trigrams at the start of long texts are taken into account.
+	sharpness   = 8.0  // How strongly the average evidence per trigram separates the languages.
+)
+
+// profiles holds a sample text per language, named after its ISO 639-1 code.
+//
+//go:embed profiles/*.txt
+var profiles embed.FS
+
+// Detector guesses the language of a text by comparing its letter trigrams with the trigram profiles
+// built from the embedded samples.
+type Detector struct {
+	languages []string                      // The ISO 639-1 codes of the known languages, sorted.
+	profiles  map[string]map[string]float64 // The log probability of each trigram by language.
+	unseen    map[string]float64            // The log probability of a trigram missing from the profile.
+}
+
+// NewDetector creates and returns a new Detector instance backed by the embedded profiles.
+func NewDetector() (*Detector, error) {
+	entries, err := profiles.ReadDir("profiles")
+	if err != nil {
+		return nil, fmt.Errorf("read profiles: %w", err)
+	}
+
+	d := &Detector{profiles: make(map[string]map[string]float64), unseen: make(map[string]float64)}
+	for _, entry := range entries {
+		data, err := profiles.ReadFile(path.Join("profiles", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read profile %s: %w", entry.Name(), err)
+		}
+
+		code := strings.TrimSuffix(entry.Name(), ".txt")
+		counts, total := make(map[string]int), 0
+		for _, trigram := range trigrams(string(data), math.MaxInt) {
+			counts[trigram]++
+			total++
+		}
+		if total == 0 {
+			return nil, fmt.Errorf("read profile %s: no trigrams", entry.Name())
+		}
+
+		// Laplace smoothing, so that a single unknown trigram does not rule a language out.
+		denominator := float64(total + len(counts) + 1)
+		profile := make(map[string]float64, len(counts))
+		for trigram, count := range counts {
+			profile[trigram] = math.Log(float64(count+1) / denominator)
+		}
+		d.profiles[code], d.unseen[code] = profile, math.Log(1/denominator)
+		d.languages = append(d.languages, code)
+	}
+	sort.Strings(d.languages)
+	return d, nil
+}
+
+// Languages returns the ISO 639-1 codes of the languages the detector knows.
+func (d *Detector) Languages() []string {
+	return append([]string(nil), d.languages...)
+}
+
+// Detect returns the ISO 639-1 code of the language the texts are written in, together with a confidence
+// from 0 to 1 that the language is the right one among the known languages.
+// It returns an empty code when the texts are too short to tell.
+func (d *Detector) Detect(texts ...string) (code string, confidence float64) {
+	grams := trigrams(strings.Join(texts, "\n"), maxTrigrams)
+	if len(grams) < minTrigrams {
+		return "", 0
+	}
+
+	scores := make(map[string]float64, len(d.languages))
+	for _, trigram := range grams {
+		for _, language := range d.languages {
+			score, ok := d.profiles[language][trigram]
+			if !ok {
+				score = d.unseen[language]
+			}
+			scores[language] += score
+		}
+	}
+
+	for _, language := range d.languages {
+		if code == "" || scores[language] > scores[code] {
+			code = language
+		}
+	}
+
+	// Neighbouring trigrams are far from independent, so the evidence is averaged per trigram instead of
+	// summed, which keeps the confidence of long texts from always rounding up to 1.
+	var total float64
+	for _, language := range d.languages {
+		total += math.Exp((scores[language] - scores[code]) / float64(len(grams)) * sharpness)
+	}
+	return code, math.Round(100/total) / 100
+}
+
+// trigrams returns up to limit lower-case letter trigrams of text, each word padded with a space on both sides.
+func trigrams(text string, limit int) []string {
+	var (
+		result []string
+		word   = []rune{' '}
+	)
+	flush := func() {
+		if len(word) == 1 {
+			return
+		}
+		word = append(word, ' ')
+		for i := 0; i+3 <= len(word) && len(result) < limit; i++ {
+			result = append(result, string(word[i:i+3]))
+		}
+		word = word[:1]
+	}
+
+	for _, r := range text {
+		if len(result) >= limit {
+			break
+		}
+		if unicode.IsLetter(r) {
+			word = append(word, unicode.ToLower(r))
+			continue
+		}
+		if r == '\'' || r == '’' {
+			continue
+		}
+		flush()
+	}
+	flush()
+	return result
+}
@@ -0,0 +1,57 @@
+package run
+
+import (
+	"context"
+	"domain/run/entity"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository provides a MongoDB based implementation for managing run reports.
+type Repository struct {
+	client     *mongo.Client     // MongoDB client instance.
+	collection *mongo.Collection // MongoDB collection for storing run reports.
+}
+
+// NewRepository creates a new Repository instance.
+func NewRepository(client *mongo.Client, collection *mongo.Collection) *Repository {
+	return &Repository{client: client, collection: collection}
+}
+
+// Save persists a new run report into the MongoDB collection.
+func (r *Repository) Save(ctx context.Context, run *entity.Run) error {
+	if run.ID.IsZero() {
+		run.ID = primitive.NewObjectID()
+	}
+	if _, err := r.collection.InsertOne(ctx, run); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// FetchLatest retrieves the most recent run reports, newest first.
+func (r *Repository) FetchLatest(ctx context.Context, limit int) ([]*entity.Run, error) {
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "started_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var runs []*entity.Run
+	if err = cursor.All(ctx, &runs); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return runs, nil
+}
@@ -0,0 +1,54 @@
+package salary
+
+import (
+	"domain/vacancy/entity"
+	"math"
+	"strings"
+)
+
+// periodsPerYear is the number of pay periods in a year, assuming 40 hours a week and 5 days a week.
+var periodsPerYear = map[entity.SalaryPeriod]float64{
+	entity.SalaryPeriodHour:  2080,
+	entity.SalaryPeriodDay:   260,
+	entity.SalaryPeriodWeek:  52,
+	entity.SalaryPeriodMonth: 12,
+	entity.SalaryPeriodYear:  1,
+}
+
+// Normalizer converts salaries into an annual amount in a reference currency.
+type Normalizer struct {
+	currency string             // The ISO 4217 code of the reference currency.
+	rates    map[string]float64 // The value of one unit of each currency in the reference currency.
+}
+
+// NewNormalizer creates and returns a new Normalizer instance.
+// rates holds the value of one unit of each currency in the reference currency, keyed by ISO 4217 code.
+func NewNormalizer(currency string, rates map[string]float64) *Normalizer {
+	upper := make(map[string]float64, len(rates))
+	for code, rate := range rates {
+		upper[strings.ToUpper(code)] = rate
+	}
+	return &Normalizer{currency: strings.ToUpper(currency), rates: upper}
+}
+
+// Normalize fills in the annual range of the salary in the reference currency.
+// The annual range is cleared when the currency has no known rate or the period is unknown.
+func (n *Normalizer) Normalize(s *entity.Salary) {
+	if s == nil {
+		return
+	}
+	s.AnnualMin, s.AnnualMax, s.AnnualCurrency = 0, 0, ""
+
+	rate, ok := n.rates[s.Currency]
+	if s.Currency == n.currency {
+		rate, ok = 1, true
+	}
+	periods, known := periodsPerYear[s.Period]
+	if !ok || !known || rate <= 0 {
+		return
+	}
+
+	s.AnnualMin = math.Round(s.Min * periods * rate)
+	s.AnnualMax = math.Round(s.Max * periods * rate)
+	s.AnnualCurrency = n.currency
+}
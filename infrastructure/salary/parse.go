@@ -0,0 +1,242 @@
+package salary
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/keyword"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// amount matches numbers with grouped thousands ("10 000", "10.000,50") or without ("15000", "10.5").
+	amount = `\d{1,3}(?:[ \x{a0}\x{202f}.,']\d{3})+(?:[.,]\d{1,2})?|\d+(?:[.,]\d{1,2})?`
+	// currency matches currency codes and symbols.
+	currency = `[$€£]|(?:pln|eur|usd|gbp|chf|czk|uah)\b|zł|zl\b|kč|грн`
+	// tailSize is the number of runes after an amount searched for the period, basis and contract.
+	tailSize = 60
+	// headSize is the number of runes before an amount searched for a salary keyword or a range word.
+	headSize = 40
+	// yearlyK is the smallest amount written with a "k" suffix that is taken for an annual salary.
+	yearlyK = 20000
+)
+
+// pattern matches a single amount or a range, with the currency before or after the amounts.
+var pattern = regexp.MustCompile(`(?i)(?P<pre>` + currency + `)?\s*(?P<min>` + amount + `)\s*(?P<mink>k\b)?` +
+	`\s*(?:(?:-|–|—|to|do|bis)\s*(?P<pre2>` + currency + `)?\s*(?P<max>` + amount + `)\s*(?P<maxk>k\b)?)?` +
+	`\s*(?P<post>` + currency + `)?`)
+
+// currencies maps lower-cased currency symbols and local names to their ISO 4217 code.
+var currencies = map[string]string{
+	"$": "USD", "€": "EUR", "£": "GBP", "zł": "PLN", "zl": "PLN", "kč": "CZK", "грн": "UAH",
+}
+
+// periods lists the words announcing the pay period, shorter periods first.
+var periods = []keyword.Keyword[entity.SalaryPeriod]{
+	{Words: []string{"/h", "/hr", "hour", "godz", "stunde", "/std", "heure", "hora", "час"},
+		Value: entity.SalaryPeriodHour},
+	{Words: []string{"/day", "/md", "day", "daily", "dzie", "dniówk", "tag", "jour"}, Value: entity.SalaryPeriodDay},
+	{Words: []string{"week", "tydz", "tygod", "woche", "semaine"}, Value: entity.SalaryPeriodWeek},
+	{Words: []string{"/mo", "month", "mies", "monat", "mois", "mes", "мес", "міс"}, Value: entity.SalaryPeriodMonth},
+	{Words: []string{"/y", "year", "annual", "p.a.", "rok", "rocz", "jahr", "/an", "año", "год", "рік"},
+		Value: entity.SalaryPeriodYear},
+}
+
+// salaryWords lists the words announcing a salary, matched as prefixes so that "wynagrodzeni" finds
+// "wynagrodzenie".
+var salaryWords = []keyword.Keyword[bool]{
+	{Words: []string{"salar", "pay", "compensation", "remuneration", "wage", "rate", "wynagrodz", "pensj", "stawk",
+		"widełki", "gehalt", "vergütung", "lohn", "salaire", "rémunération", "sueldo", "stipendio", "retribuzione",
+		"salário", "зарплат", "заробітн", "оклад", "ставк"}, Value: true},
+}
+
+// rangeWords lists the words bounding an amount, such as "up to" in "up to €80k".
+var rangeWords = []keyword.Keyword[bool]{
+	{Words: []string{"up to", "from", "starting at", "ab", "bis", "od", "do", "до", "від", "от"}, Value: true,
+		Exact: true},
+}
+
+// bases lists the words announcing whether the amount is gross or net.
+var bases = []keyword.Keyword[entity.SalaryBasis]{
+	{Words: []string{"gross", "brutto", "brut", "before tax"}, Value: entity.SalaryBasisGross},
+	{Words: []string{"net", "netto", "after tax"}, Value: entity.SalaryBasisNet},
+}
+
+// contracts lists the words announcing the contract type.
+var contracts = []keyword.Keyword[entity.SalaryContract]{
+	{Words: []string{"uop", "umowa o pracę", "umowa o prace", "employment contract", "permanent", "festanstellung"},
+		Value: entity.SalaryContractPermanent},
+	{Words: []string{"b2b"}, Value: entity.SalaryContractB2B},
+	{Words: []string{"uz", "uod", "umowa zlecenie", "umowa o dzieło", "freelance", "contract"},
+		Value: entity.SalaryContractContract},
+}
+
+// Parse extracts the salary announced in the text, such as "10 000 – 15 000 PLN net/month (B2B)".
+// Only amounts with a currency are considered, and only when a salary keyword precedes them, or when they are
+// a range, bounded by a word such as "up to" or followed by their period. The first amount right after a
+// salary keyword wins over the others, so that a training budget is not taken for the salary.
+// The period defaults to a month, or to an hour for small amounts and a year for large ones or those of 20k and
+// more, when the text does not announce it. Returns nil when no salary is found.
+func Parse(text string) *entity.Salary {
+	var (
+		candidate *entity.Salary
+		previous  int
+	)
+	for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		// The head is the text since the previous amount, so a keyword belongs to the amount right after it.
+		head := strings.ToLower(last(text[previous:match[0]], headSize))
+		previous = match[1]
+
+		group := func(name string) string {
+			i := pattern.SubexpIndex(name)
+			if match[2*i] < 0 {
+				return ""
+			}
+			return text[match[2*i]:match[2*i+1]]
+		}
+
+		code := normalizeCurrency(firstOf(group("pre"), group("pre2"), group("post")))
+		if code == "" {
+			continue
+		}
+
+		s := &entity.Salary{Currency: code}
+		s.Min = parseAmount(group("min"), group("mink") != "")
+		s.Max = s.Min
+		if group("max") != "" {
+			s.Max = parseAmount(group("max"), group("maxk") != "")
+			if group("mink") == "" && group("maxk") != "" && s.Min < 1000 {
+				s.Min *= 1000 // "10-15k"
+			}
+		}
+		if s.Max < s.Min {
+			s.Min, s.Max = s.Max, s.Min
+		}
+		if s.Min <= 0 {
+			continue
+		}
+
+		fallback := defaultPeriod(s.Max)
+		if (group("mink") != "" || group("maxk") != "") && s.Max >= yearlyK {
+			fallback = entity.SalaryPeriodYear // "€80k" is an annual salary.
+		}
+		tail := strings.ToLower(truncate(text[match[1]:], tailSize))
+		s.Period = keyword.Find(tail, periods, "")
+		announced := s.Period != ""
+		if !announced {
+			s.Period = fallback
+		}
+		s.Basis = keyword.Find(tail, bases, "")
+		s.Contract = keyword.Find(tail, contracts, "")
+
+		switch {
+		case keyword.Find(head, salaryWords, false):
+			return s
+		case candidate == nil && (group("max") != "" || keyword.Find(head, rangeWords, false) || announced):
+			candidate = s
+		}
+	}
+	return candidate
+}
+
+// PeriodOf converts a schema.org unit text such as HOUR or MONTH, guessing the period from the upper bound of
+// the amount when the unit is missing or unknown.
+func PeriodOf(unit string, max float64) entity.SalaryPeriod {
+	switch strings.ToUpper(strings.TrimSpace(unit)) {
+	case "HOUR":
+		return entity.SalaryPeriodHour
+	case "DAY":
+		return entity.SalaryPeriodDay
+	case "WEEK":
+		return entity.SalaryPeriodWeek
+	case "MONTH":
+		return entity.SalaryPeriodMonth
+	case "YEAR":
+		return entity.SalaryPeriodYear
+	default:
+		return defaultPeriod(max)
+	}
+}
+
+// normalizeCurrency returns the ISO 4217 code of a currency symbol or code.
+func normalizeCurrency(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if code, ok := currencies[value]; ok {
+		return code
+	}
+	return strings.ToUpper(value)
+}
+
+// parseAmount parses an amount, telling grouped thousands from decimals by the digits after the last separator.
+func parseAmount(value string, thousands bool) float64 {
+	integer, fraction := value, ""
+	if i := strings.LastIndexAny(value, ".,"); i >= 0 && len(value)-i-1 <= 2 {
+		integer, fraction = value[:i], value[i+1:]
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, integer)
+	if fraction != "" {
+		digits += "." + fraction
+	}
+
+	n, err := strconv.ParseFloat(digits, 64)
+	if err != nil {
+		return 0
+	}
+	if thousands {
+		n *= 1000
+	}
+	return n
+}
+
+// defaultPeriod guesses the pay period of an amount whose period is not announced.
+func defaultPeriod(max float64) entity.SalaryPeriod {
+	switch {
+	case max < 500:
+		return entity.SalaryPeriodHour
+	case max >= 100000:
+		return entity.SalaryPeriodYear
+	default:
+		return entity.SalaryPeriodMonth
+	}
+}
+
+// firstOf returns the first non-empty value.
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// last returns at most the last n runes of s.
+func last(s string, n int) string {
+	for i := len(s); i > 0; {
+		_, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
+		if n--; n == 0 {
+			return s[i:]
+		}
+	}
+	return s
+}
+
+// truncate returns at most n runes of s.
+func truncate(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
@@ -0,0 +1,151 @@
+package skills
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// listSeparator matches the text between two items of an enumeration such as "Go, Kafka" or "Go and Kafka".
+var listSeparator = regexp.MustCompile(`(?i)^[\s,/&+|;()•·-]*(?:and|or|und|oder|i|oraz|et|y)?[\s,/&+|;()•·-]*$`)
+
+// contextWindow is the number of words before and after an ambiguous alias searched for context words.
+const contextWindow = 2
+
+// pattern matches the aliases of a skill in text.
+type pattern struct {
+	tag       string         // The tag of the skill.
+	re        *regexp.Regexp // Matches any of the aliases, optionally followed by a version such as "1.22".
+	ambiguous bool           // Whether the aliases are also common words.
+}
+
+// match is an alias found in text.
+type match struct {
+	tag        string // The tag of the skill.
+	start, end int    // The position of the alias in the text.
+	confident  bool   // Whether the alias is taken for the skill without further evidence.
+}
+
+// Extractor finds the skills mentioned in vacancy text.
+type Extractor struct {
+	version  string              // The version of the taxonomy.
+	patterns []pattern           // The patterns of every skill.
+	context  map[string]struct{} // The context words, lower-cased.
+}
+
+// NewExtractor creates and returns a new Extractor instance for the given taxonomy.
+func NewExtractor(taxonomy *Taxonomy) *Extractor {
+	e := &Extractor{version: taxonomy.Version, context: make(map[string]struct{}, len(taxonomy.Context))}
+	for _, word := range taxonomy.Context {
+		e.context[strings.ToLower(word)] = struct{}{}
+	}
+	for _, skill := range taxonomy.Skills {
+		if len(skill.Aliases) > 0 {
+			e.patterns = append(e.patterns, pattern{tag: skill.Tag, re: compile(skill.Aliases)})
+		}
+		if len(skill.Ambiguous) > 0 {
+			e.patterns = append(e.patterns, pattern{tag: skill.Tag, re: compile(skill.Ambiguous), ambiguous: true})
+		}
+	}
+	return e
+}
+
+// Version returns the version of the taxonomy.
+func (e *Extractor) Version() string { return e.version }
+
+// Extract returns the sorted tags of the skills mentioned in the given texts.
+// Ambiguous aliases such as "go" count only when followed by a version ("Go 1.22"), or when capitalised and
+// either next to a context word ("Go developer") or enumerated together with another skill ("Go, Kafka").
+func (e *Extractor) Extract(texts ...string) []string {
+	found := make(map[string]struct{})
+	for _, text := range texts {
+		for _, tag := range e.extract(text) {
+			found[tag] = struct{}{}
+		}
+	}
+
+	tags := make([]string, 0, len(found))
+	for tag := range found {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// extract returns the tags of the skills mentioned in a single text.
+func (e *Extractor) extract(text string) []string {
+	var matches []match
+	for _, p := range e.patterns {
+		for _, loc := range p.re.FindAllStringSubmatchIndex(text, -1) {
+			m := match{tag: p.tag, start: loc[2], end: loc[3], confident: !p.ambiguous}
+			if p.ambiguous && loc[4] >= 0 {
+				m.confident = true // Followed by a version.
+			}
+			matches = append(matches, m)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+
+	var tags []string
+	for i, m := range matches {
+		if m.confident || e.evident(text, matches, i) {
+			tags = append(tags, m.tag)
+		}
+	}
+	return tags
+}
+
+// evident reports whether the ambiguous alias of matches[i] is capitalised and either next to a context word or
+// enumerated together with a confident skill.
+func (e *Extractor) evident(text string, matches []match, i int) bool {
+	m := matches[i]
+	if alias := text[m.start:m.end]; alias == strings.ToLower(alias) {
+		return false
+	}
+
+	before := strings.FieldsFunc(text[:m.start], notLetter)
+	after := strings.FieldsFunc(text[m.end:], notLetter)
+	for j := 1; j <= contextWindow; j++ {
+		if j <= len(before) && e.isContext(before[len(before)-j]) || j <= len(after) && e.isContext(after[j-1]) {
+			return true
+		}
+	}
+
+	for _, other := range matches {
+		switch {
+		case !other.confident:
+			continue
+		case other.end <= m.start && listSeparator.MatchString(text[other.end:m.start]):
+			return true
+		case other.start >= m.end && listSeparator.MatchString(text[m.end:other.start]):
+			return true
+		}
+	}
+	return false
+}
+
+// isContext reports whether word is a context word.
+func (e *Extractor) isContext(word string) bool {
+	_, ok := e.context[strings.ToLower(word)]
+	return ok
+}
+
+// compile builds the pattern matching any of the aliases as a whole word, optionally followed by a version.
+// Longer aliases come first so that "spring boot" wins over "spring", and spaces match any run of spaces or dashes.
+func compile(aliases []string) *regexp.Regexp {
+	sorted := append([]string(nil), aliases...)
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	quoted := make([]string, len(sorted))
+	for i, alias := range sorted {
+		quoted[i] = strings.ReplaceAll(regexp.QuoteMeta(strings.ToLower(alias)), " ", `[\s-]+`)
+	}
+	return regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}+#.])(` + strings.Join(quoted, "|") + `)` +
+		`(\s?v?\d+(?:\.\d+)*)?(?:[^\p{L}\p{N}+#]|$)`)
+}
+
+// notLetter reports whether r separates words.
+func notLetter(r rune) bool {
+	return !unicode.IsLetter(r)
+}
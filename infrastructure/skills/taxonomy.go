@@ -0,0 +1,59 @@
+package skills
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// defaultTaxonomy is the taxonomy shipped with the bot, used when no taxonomy file is configured.
+//
+//go:embed taxonomy.json
+var defaultTaxonomy []byte
+
+// Taxonomy lists the known skills together with the words that make an ambiguous alias a skill.
+// Bump the version whenever the taxonomy changes, so stored vacancies are tagged again.
+type Taxonomy struct {
+	Version string   `json:"version"` // The version of the taxonomy.
+	Context []string `json:"context"` // Words next to which an ambiguous alias is taken for a skill.
+	Skills  []Skill  `json:"skills"`  // The known skills.
+}
+
+// Skill is a normalised tag with the aliases it is written as.
+type Skill struct {
+	Tag       string   `json:"tag"`       // The normalised tag stored on vacancies, e.g. "kubernetes".
+	Aliases   []string `json:"aliases"`   // Aliases always taken for the skill, e.g. "k8s".
+	Ambiguous []string `json:"ambiguous"` // Aliases that are also common words, e.g. "go", needing further evidence.
+}
+
+// Load reads the taxonomy from the JSON file at path, or returns the embedded taxonomy when path is empty.
+func Load(path string) (*Taxonomy, error) {
+	if path == "" {
+		return Parse(defaultTaxonomy)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read taxonomy: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a taxonomy from JSON.
+func Parse(data []byte) (*Taxonomy, error) {
+	var taxonomy Taxonomy
+	if err := json.Unmarshal(data, &taxonomy); err != nil {
+		return nil, fmt.Errorf("decode taxonomy: %w", err)
+	}
+
+	for _, skill := range taxonomy.Skills {
+		if skill.Tag == "" {
+			return nil, fmt.Errorf("decode taxonomy: skill without tag")
+		}
+		if len(skill.Aliases) == 0 && len(skill.Ambiguous) == 0 {
+			return nil, fmt.Errorf("decode taxonomy: skill %q has no aliases", skill.Tag)
+		}
+	}
+	return &taxonomy, nil
+}
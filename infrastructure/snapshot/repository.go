@@ -0,0 +1,157 @@
+package snapshot
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"domain/snapshot/entity"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// extension is the file extension of stored snapshots.
+	extension = ".json.gz"
+	// tmpExtension is the file extension of snapshots being written.
+	tmpExtension = ".tmp"
+)
+
+// Repository provides a filesystem based, content-addressed implementation for storing snapshots.
+// Each snapshot is stored as gzip compressed JSON under <root>/<first two characters of ID>/<ID>.json.gz.
+// The ID covers the requested URL as well as the body, so that pages of different URLs serving the same
+// body keep their own metadata.
+type Repository struct {
+	root string // Directory the snapshots are stored in.
+}
+
+// NewRepository creates a new Repository instance storing snapshots under root.
+func NewRepository(root string) *Repository {
+	return &Repository{root: root}
+}
+
+// Save writes the snapshot to the filesystem, deriving its ID from the SHA-256 hash of the URL and body.
+func (r *Repository) Save(ctx context.Context, snapshot *entity.Snapshot) (err error) {
+	if err = ctx.Err(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(snapshot.URL))
+	hash.Write([]byte{0}) // Separates the URL from the body.
+	hash.Write([]byte(snapshot.Body))
+	snapshot.ID = hex.EncodeToString(hash.Sum(nil))
+
+	path := r.path(snapshot.ID)
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	// Write to a temporary file first, so readers never see a partially written snapshot.
+	tmp, err := os.CreateTemp(filepath.Dir(path), snapshot.ID+".*"+tmpExtension)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	writer := gzip.NewWriter(tmp)
+	if err = json.NewEncoder(writer).Encode(snapshot); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("compress snapshot: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename file: %w", err)
+	}
+	return nil
+}
+
+// FindByID reads the snapshot with the given ID from the filesystem.
+func (r *Repository) FindByID(ctx context.Context, id string) (snapshot *entity.Snapshot, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if decoded, dErr := hex.DecodeString(id); dErr != nil || len(decoded) != sha256.Size {
+		return nil, fmt.Errorf("invalid snapshot id %q", id)
+	}
+
+	file, err := os.Open(r.path(id))
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+	defer func() {
+		if cErr := file.Close(); cErr != nil {
+			fmt.Printf("close snapshot: %v\n", cErr)
+		}
+	}()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot: %w", err)
+	}
+	snapshot = &entity.Snapshot{}
+	if err = json.NewDecoder(reader).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// Purge removes snapshots last written before the given time. Temporary files left behind by saves that
+// failed to rename them, or that were interrupted, are removed too, but not counted.
+func (r *Repository) Purge(ctx context.Context, before time.Time) (removed int, err error) {
+	err = filepath.WalkDir(r.root, func(path string, d fs.DirEntry, wErr error) error {
+		if wErr != nil {
+			return wErr
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		stray := strings.HasSuffix(path, tmpExtension)
+		if d.IsDir() || !strings.HasSuffix(path, extension) && !stray {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.ModTime().Before(before) {
+			return nil
+		}
+		if err = os.Remove(path); err != nil {
+			return err
+		}
+		if !stray {
+			removed++
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return removed, nil
+	}
+	if err != nil {
+		return removed, fmt.Errorf("purge snapshots: %w", err)
+	}
+	return removed, nil
+}
+
+// path returns the file path of the snapshot with the given ID.
+func (r *Repository) path(id string) string {
+	return filepath.Join(r.root, id[:2], id+extension)
+}
@@ -34,9 +34,44 @@ func (r *Repository) Save(ctx context.Context, url *entity.Url) error {
 	return nil
 }
 
-// FetchBatch retrieves a batch of URLs with the specified status from MongoDB.
-func (r *Repository) FetchBatch(ctx context.Context, status string, limit int) ([]*entity.Url, error) {
-	filter := bson.M{"status": status}
+// SaveIfNew inserts the URL entity unless a document with the same address already exists.
+// The source of an existing document is set too, so that URLs stored before they were scoped by source
+// are picked up by their source again. Addresses are unique, an insert racing another run for the same
+// address is reported as not inserted.
+func (r *Repository) SaveIfNew(ctx context.Context, url *entity.Url) (bool, error) {
+	raw, err := bson.Marshal(url)
+	if err != nil {
+		return false, fmt.Errorf("marshal url: %w", err)
+	}
+	var insert bson.M
+	if err = bson.Unmarshal(raw, &insert); err != nil {
+		return false, fmt.Errorf("unmarshal url: %w", err)
+	}
+	delete(insert, "source") // Set for existing documents as well, a path can not be in both operators.
+
+	filter := bson.M{"address": url.Address}
+	update := bson.M{"$setOnInsert": insert, "$set": bson.M{"source": url.Source}}
+	opt := options.Update().SetUpsert(true)
+
+	res, err := r.collection.UpdateOne(ctx, filter, update, opt)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	if res.UpsertedCount == 0 {
+		return false, nil
+	}
+	if oid, ok := res.UpsertedID.(primitive.ObjectID); ok {
+		url.ID = oid
+	}
+	return true, nil
+}
+
+// FetchBatch retrieves a batch of URLs of the given source with the specified status from MongoDB.
+func (r *Repository) FetchBatch(ctx context.Context, source, status string, limit int) ([]*entity.Url, error) {
+	filter := bson.M{"source": source, "status": status}
 	opt := options.Find().SetLimit(int64(limit))
 
 	cursor, err := r.collection.Find(ctx, filter, opt)
@@ -79,3 +114,143 @@ func (r *Repository) UpdateStatus(ctx context.Context, id, status string, proces
 	}
 	return nil
 }
+
+// MarkFailed sets the status of URL entity to "failed" and records the failure reason and HTTP status.
+func (r *Repository) MarkFailed(ctx context.Context, id, reason string, httpStatus int, processedTime *time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	set := bson.M{
+		"status":         "failed",
+		"failure_reason": reason,
+		"http_status":    httpStatus,
+	}
+	if processedTime != nil {
+		set["processed"] = *processedTime
+	}
+
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("no document found with the id %s", id)
+	}
+	return nil
+}
+
+// SetSnapshot links the URL entity to the stored snapshot of its latest fetch.
+func (r *Repository) SetSnapshot(ctx context.Context, id, snapshotID string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	update := bson.M{"$set": bson.M{"snapshot_id": snapshotID}}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("no document found with the id %s", id)
+	}
+	return nil
+}
+
+// MarkSeen records that a run of the source found the given addresses in its sitemap at seenAt.
+// Their misses are reset, and delisted ones are made pending again.
+func (r *Repository) MarkSeen(ctx context.Context, source string, addresses []string, seenAt time.Time) error {
+	const chunkSize = 1000
+
+	for start := 0; start < len(addresses); start += chunkSize {
+		chunk := addresses[start:min(start+chunkSize, len(addresses))]
+		filter := bson.M{"source": source, "address": bson.M{"$in": chunk}}
+
+		update := bson.M{"$set": bson.M{"seen_at": seenAt}, "$unset": bson.M{"misses": ""}}
+		if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("update seen documents: %w", err)
+		}
+
+		filter["status"] = "delisted"
+		update = bson.M{"$set": bson.M{"status": "pending"}}
+		if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("update relisted documents: %w", err)
+		}
+	}
+	return nil
+}
+
+// MarkMissing counts a miss for every URL of the source not seen by the run at seenAt, and sets the status of
+// the URLs missing for maxMisses consecutive runs to "delisted". URLs found closed are left alone.
+// Returns the URLs delisted by this call.
+func (r *Repository) MarkMissing(
+	ctx context.Context,
+	source string,
+	seenAt time.Time,
+	maxMisses int,
+) ([]*entity.Url, error) {
+	filter := bson.M{
+		"source":  source,
+		"status":  bson.M{"$nin": []string{"delisted", "closed"}},
+		"seen_at": bson.M{"$not": bson.M{"$gte": seenAt}},
+	}
+	if _, err := r.collection.UpdateMany(ctx, filter, bson.M{"$inc": bson.M{"misses": 1}}); err != nil {
+		return nil, fmt.Errorf("update missing documents: %w", err)
+	}
+
+	filter = bson.M{
+		"source": source,
+		"status": bson.M{"$nin": []string{"delisted", "closed"}},
+		"misses": bson.M{"$gte": maxMisses},
+	}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var urls []*entity.Url
+	if err = cursor.All(ctx, &urls); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]primitive.ObjectID, len(urls))
+	for i, url := range urls {
+		ids[i] = url.ID
+		url.Status = "delisted"
+	}
+	update := bson.M{"$set": bson.M{"status": "delisted"}}
+	if _, err = r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update); err != nil {
+		return nil, fmt.Errorf("update delisted documents: %w", err)
+	}
+	return urls, nil
+}
+
+// Requeue sets the status of the successful and failed URLs of the source processed before the given time
+// back to "pending", clearing the failure of the previous attempt. Returns the number of requeued URLs.
+func (r *Repository) Requeue(ctx context.Context, source string, processedBefore time.Time) (int, error) {
+	filter := bson.M{
+		"source":    source,
+		"status":    bson.M{"$in": []string{"success", "failed"}},
+		"processed": bson.M{"$lt": processedBefore},
+	}
+	update := bson.M{
+		"$set":   bson.M{"status": "pending"},
+		"$unset": bson.M{"failure_reason": "", "http_status": ""},
+	}
+
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("update requeued documents: %w", err)
+	}
+	return int(res.ModifiedCount), nil
+}
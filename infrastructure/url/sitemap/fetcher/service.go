@@ -2,11 +2,16 @@ package fetcher
 
 import (
 	"context"
+	"domain/pipeline"
 	"fmt"
+	"infrastructure/html"
 	"io"
 	"net/http"
 )
 
+// maxErrorBodySize is the number of bytes read from an unsuccessful response to classify it.
+const maxErrorBodySize = 64 * 1024
+
 // Service handles fetching HTML content from a given URL.
 type Service struct {
 	clientProvider func() (*http.Client, error) // Provides an HTTP client.
@@ -33,11 +38,17 @@ func (s *Service) Fetch(ctx context.Context, url string) (io.ReadCloser, error)
 	// Perform the HTTP request.
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
+		return nil, pipeline.Network(fmt.Errorf("do request: %w", err))
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http status: %d", resp.StatusCode)
+		defer func() {
+			if cErr := resp.Body.Close(); cErr != nil {
+				fmt.Printf("close response body: %v\n", cErr)
+			}
+		}()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("check response: %w", html.CheckResponse(resp.StatusCode, body))
 	}
 
 	return resp.Body, nil
@@ -3,8 +3,11 @@ package parser
 import (
 	"encoding/xml"
 	"fmt"
+	"infrastructure/date"
 	"io"
 	"net/url"
+	"strings"
+	"time"
 )
 
 // RssFeed is responsible for parsing HTML content and extracting URLs.
@@ -14,16 +17,37 @@ type RssFeed struct{}
 type RSS struct {
 	Channel struct {
 		Items []struct {
-			Link string `xml:"link"`
+			Link    string `xml:"link"`
+			PubDate string `xml:"pubDate"`
 		} `xml:"item"`
 	} `xml:"channel"`
 }
 
+// Entry is a URL announced by a feed together with its publication date.
+type Entry struct {
+	URL         string    // URL of the item.
+	PublishedAt time.Time // Publication date of the item, zero when the feed does not announce a valid one.
+}
+
 // NewRssFeed creates and returns a new RssFeed instance.
 func NewRssFeed() *RssFeed { return &RssFeed{} }
 
 // Parse decodes the RSS feed content from the provided body.
 func (f *RssFeed) Parse(body io.Reader) ([]string, error) {
+	entries, err := f.ParseEntries(body)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, len(entries))
+	for i, entry := range entries {
+		urls[i] = entry.URL
+	}
+	return urls, nil
+}
+
+// ParseEntries decodes the RSS feed content from the provided body, keeping the publication date of every item.
+func (f *RssFeed) ParseEntries(body io.Reader) ([]Entry, error) {
 	var rss RSS
 	if err := xml.NewDecoder(body).Decode(&rss); err != nil {
 		return nil, fmt.Errorf("parse RSS feed: %w", err)
@@ -33,15 +57,21 @@ func (f *RssFeed) Parse(body io.Reader) ([]string, error) {
 		return nil, fmt.Errorf("no items found in RSS feed")
 	}
 
-	urls := make([]string, len(rss.Channel.Items))
-	for i, item := range rss.Channel.Items {
-		parsedURL, err := url.Parse(item.Link)
+	now := time.Now()
+	entries := make([]Entry, 0, len(rss.Channel.Items))
+	for _, item := range rss.Channel.Items {
+		parsedURL, err := url.Parse(strings.TrimSpace(item.Link))
 		if err != nil {
 			fmt.Printf("invalid URL in RSS feed: %s, error: %v", item.Link, err)
 			continue
 		}
-		urls[i] = parsedURL.String()
+
+		entry := Entry{URL: parsedURL.String()}
+		if published, dErr := date.ParseAbsolute(item.PubDate, now); dErr == nil {
+			entry.PublishedAt = published
+		}
+		entries = append(entries, entry)
 	}
 
-	return urls, nil
+	return entries, nil
 }
@@ -5,6 +5,7 @@ import (
 	"domain/url/entity"
 	"domain/url/repository"
 	"fmt"
+	"infrastructure/url/sitemap/parser"
 	"time"
 )
 
@@ -18,19 +19,62 @@ func NewService(r repository.UrlRepository) *Service {
 	return &Service{urlRepository: r}
 }
 
-// SaveUrls saves a batch of URLs to the data source.
-func (s *Service) SaveUrls(ctx context.Context, urls []string) error {
-	for _, url := range urls {
+// SaveUrls saves a batch of URLs belonging to the given source to the data source.
+// URLs that are already stored are skipped. Returns the number of newly stored URLs.
+func (s *Service) SaveUrls(ctx context.Context, source string, urls []string) (saved int, err error) {
+	entries := make([]parser.Entry, len(urls))
+	for i, url := range urls {
+		entries[i] = parser.Entry{URL: url}
+	}
+	return s.SaveEntries(ctx, source, entries)
+}
+
+// SaveEntries saves a batch of feed entries belonging to the given source to the data source,
+// keeping the publication date announced by the feed. URLs that are already stored are skipped.
+// Returns the number of newly stored URLs.
+func (s *Service) SaveEntries(ctx context.Context, source string, entries []parser.Entry) (saved int, err error) {
+	for _, entry := range entries {
 		item := entity.Url{
-			Address:   url,
-			Status:    "pending",
-			Processed: time.Time{}, // Not yet processed.
+			Address:     entry.URL,
+			Source:      source,
+			Status:      "pending",
+			Processed:   time.Time{}, // Not yet processed.
+			PublishedAt: entry.PublishedAt,
 		}
 
-		if err := s.urlRepository.Save(ctx, &item); err != nil {
-			return fmt.Errorf("save URL %s: %w", url, err)
+		created, err := s.urlRepository.SaveIfNew(ctx, &item)
+		if err != nil {
+			return saved, fmt.Errorf("save URL %s: %w", entry.URL, err)
 		}
+		if created {
+			saved++
+		}
+	}
+
+	return saved, nil
+}
+
+// Delist diffs the entries a run found in the sitemap of the source at seenAt against the stored URLs.
+// Stored URLs missing from maxMisses consecutive runs are delisted, and returned.
+func (s *Service) Delist(
+	ctx context.Context,
+	source string,
+	entries []parser.Entry,
+	seenAt time.Time,
+	maxMisses int,
+) ([]*entity.Url, error) {
+	seenAt = seenAt.Truncate(time.Millisecond) // Stored times keep milliseconds only.
+	addresses := make([]string, len(entries))
+	for i, entry := range entries {
+		addresses[i] = entry.URL
+	}
+	if err := s.urlRepository.MarkSeen(ctx, source, addresses, seenAt); err != nil {
+		return nil, fmt.Errorf("mark seen URLs: %w", err)
 	}
 
-	return nil
+	delisted, err := s.urlRepository.MarkMissing(ctx, source, seenAt, maxMisses)
+	if err != nil {
+		return nil, fmt.Errorf("mark missing URLs: %w", err)
+	}
+	return delisted, nil
 }
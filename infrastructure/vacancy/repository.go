@@ -3,7 +3,10 @@ package vacancy
 import (
 	"context"
 	"domain/vacancy/entity"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -77,8 +80,116 @@ func (r *Repository) Fetch(
 }
 
 // FetchBatch retrieves a batch of vacancies where the SentAt field is not set.
+// Quarantined and closed vacancies are never returned.
 func (r *Repository) FetchBatch(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
-	filter := bson.M{"sent_at": primitive.NewDateTimeFromTime(time.Time{})}
+	filter := bson.M{
+		"sent_at":     primitive.NewDateTimeFromTime(time.Time{}),
+		"quarantined": bson.M{"$ne": true},
+		"closed_at":   bson.M{"$exists": false},
+	}
+	opt := options.Find().SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var list []*entity.Vacancy
+	if err = cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return list, nil
+}
+
+// FetchOutdated retrieves vacancies produced by the named parser with a version other than the given one.
+// Vacancies without a parser are matched by their source name or the host of their source URL.
+func (r *Repository) FetchOutdated(
+	ctx context.Context,
+	parser, version string,
+	hosts []string,
+	afterID string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	legacy := bson.A{bson.M{"source_name": parser}}
+	for _, host := range hosts {
+		host = strings.TrimPrefix(strings.ToLower(host), "www.")
+		pattern := `^https?://(www\.)?` + regexp.QuoteMeta(host) + `([:/?#]|$)`
+		legacy = append(legacy, bson.M{"source_url": bson.M{"$regex": pattern, "$options": "i"}})
+	}
+	filter := bson.M{"$or": bson.A{
+		bson.M{"parser": parser},
+		bson.M{"parser": bson.M{"$exists": false}, "$or": legacy},
+	}}
+	if version != "" {
+		filter["parser_version"] = bson.M{"$ne": version}
+	}
+	if afterID != "" {
+		oid, err := primitive.ObjectIDFromHex(afterID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		filter["_id"] = bson.M{"$gt": oid}
+	}
+	opt := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var list []*entity.Vacancy
+	if err = cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return list, nil
+}
+
+// FetchByFingerprint retrieves up to limit vacancies with the given fingerprint, excluding quarantined ones,
+// newest first.
+func (r *Repository) FetchByFingerprint(ctx context.Context, fingerprint string, limit int) ([]*entity.Vacancy, error) {
+	filter := bson.M{
+		"fingerprint": fingerprint,
+		"quarantined": bson.M{"$ne": true},
+	}
+	opt := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err = cursor.Close(ctx); err != nil {
+			fmt.Println("cursor.Close", err)
+		}
+	}()
+
+	var list []*entity.Vacancy
+	if err = cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return list, nil
+}
+
+// FetchClosed retrieves up to limit closed vacancies that are still published on the remote service.
+func (r *Repository) FetchClosed(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	filter := bson.M{
+		"closed_at": bson.M{"$exists": true},
+		"remote_id": bson.M{"$exists": true},
+	}
 	opt := options.Find().SetLimit(int64(limit))
 
 	cursor, err := r.collection.Find(ctx, filter, opt)
@@ -98,6 +209,56 @@ func (r *Repository) FetchBatch(ctx context.Context, limit int) ([]*entity.Vacan
 	return list, nil
 }
 
+// ClusterSent reports whether an open vacancy of the given cluster was already sent.
+func (r *Repository) ClusterSent(ctx context.Context, clusterID string) (bool, error) {
+	filter := bson.M{
+		"cluster_id": clusterID,
+		"sent_at":    bson.M{"$gt": primitive.NewDateTimeFromTime(time.Time{})},
+		"closed_at":  bson.M{"$exists": false},
+	}
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return count > 0, nil
+}
+
+// ReleaseCluster resets the SentAt field of the open vacancies of the given cluster that were marked as sent
+// without being published, so that one of them is sent in place of a retracted duplicate.
+func (r *Repository) ReleaseCluster(ctx context.Context, clusterID string) (int, error) {
+	filter := bson.M{
+		"cluster_id":  clusterID,
+		"sent_at":     bson.M{"$gt": primitive.NewDateTimeFromTime(time.Time{})},
+		"remote_id":   bson.M{"$exists": false},
+		"closed_at":   bson.M{"$exists": false},
+		"quarantined": bson.M{"$ne": true},
+	}
+	update := bson.M{"$set": bson.M{"sent_at": primitive.NewDateTimeFromTime(time.Time{})}}
+
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("update released documents: %w", err)
+	}
+	return int(res.ModifiedCount), nil
+}
+
+// FindBySource retrieves the vacancy of the named source with the given external ID, or source URL.
+func (r *Repository) FindBySource(ctx context.Context, source, externalID, sourceURL string) (*entity.Vacancy, error) {
+	filter := bson.M{"source_name": source, "source_url": sourceURL}
+	if externalID != "" {
+		filter = bson.M{"source_name": source, "external_id": externalID}
+	}
+
+	var vacancy entity.Vacancy
+	if err := r.collection.FindOne(ctx, filter).Decode(&vacancy); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+	return &vacancy, nil
+}
+
 // FindByID retrieves a vacancy entity by its ID from the MongoDB collection.
 func (r *Repository) FindByID(ctx context.Context, id string) (*entity.Vacancy, error) {
 	oid, err := primitive.ObjectIDFromHex(id)
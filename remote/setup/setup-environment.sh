@@ -13,7 +13,8 @@
 #        and collections for the application.
 #     7. Installing and verifying a Tor proxy setup.
 #     8. Setting environment variables for system-wide use.
-#     9. Rebooting the system to apply changes.
+#     9. Installing the systemd services of the crawler and the cron scheduler.
+#    10. Rebooting the system to apply changes.
 #
 # USAGE:
 #   1. Copy this script to a fresh server.
@@ -44,11 +45,76 @@ MONGO_PORT=27017
 MONGO_DB=bot_db
 MONGO_URLS_COLLECTION=urls
 MONGO_VACANCY_COLLECTION=vacancies
+MONGO_RUNS_COLLECTION=runs
+MONGO_COVERAGE_COLLECTION=coverage
+MONGO_COMPANY_COLLECTION=companies
+SNAPSHOT_DIR=/var/lib/pulse-finder/snapshots
+SNAPSHOT_RETENTION=720h
 
 SOURCE_ALFA_SITEMAP_URL=
 SOURCE_BETA_SITEMAP_URL=
 SOURCE_GAMMA_SITEMAP_URL=
 SOURCE_BATCH_SIZE=1
+SOURCE_TIMEOUT=2h
+SOURCE_MAX_REQUESTS=10
+
+# Per-source crawl settings, see SourceConfig in application/config for their meaning
+SOURCE_SETTINGS="CONCURRENCY INTERVAL JITTER ACTIVE_HOURS DRAIN_TIMEOUT BATCH_DELAY DELIST_AFTER RECRAWL_AFTER
+REQUESTS_PER_MINUTE BURST MIN_DELAY RESPECT_CRAWL_DELAY HOSTS DRIFT_WINDOW DRIFT_MIN_SAMPLES DRIFT_THRESHOLD
+DRIFT_PAUSE LANGUAGE_ALLOW LANGUAGE_DENY LANGUAGE_MIN_CONFIDENCE"
+SOURCE_ALFA_CONCURRENCY=5
+SOURCE_ALFA_INTERVAL=6h
+SOURCE_ALFA_JITTER=10m
+SOURCE_ALFA_ACTIVE_HOURS=
+SOURCE_ALFA_DRAIN_TIMEOUT=30s
+SOURCE_ALFA_BATCH_DELAY=15s
+SOURCE_ALFA_REQUESTS_PER_MINUTE=30
+SOURCE_ALFA_BURST=5
+SOURCE_ALFA_MIN_DELAY=1s
+SOURCE_ALFA_RESPECT_CRAWL_DELAY=true
+SOURCE_ALFA_HOSTS=
+SOURCE_ALFA_DRIFT_WINDOW=20
+SOURCE_ALFA_DRIFT_MIN_SAMPLES=5
+SOURCE_ALFA_DRIFT_THRESHOLD=0.5
+SOURCE_ALFA_DRIFT_PAUSE=24h
+SOURCE_ALFA_LANGUAGE_ALLOW=
+SOURCE_ALFA_LANGUAGE_DENY=
+SOURCE_ALFA_LANGUAGE_MIN_CONFIDENCE=0.8
+SOURCE_ALFA_DELIST_AFTER=0
+SOURCE_ALFA_RECRAWL_AFTER=24h
+SOURCE_BETA_CONCURRENCY=5
+SOURCE_BETA_INTERVAL=6h
+SOURCE_BETA_JITTER=10m
+SOURCE_BETA_ACTIVE_HOURS=
+SOURCE_BETA_DRAIN_TIMEOUT=30s
+SOURCE_BETA_BATCH_DELAY=15s
+SOURCE_BETA_REQUESTS_PER_MINUTE=30
+SOURCE_BETA_BURST=5
+SOURCE_BETA_MIN_DELAY=1s
+SOURCE_BETA_RESPECT_CRAWL_DELAY=true
+SOURCE_BETA_HOSTS=
+SOURCE_BETA_DRIFT_WINDOW=20
+SOURCE_BETA_DRIFT_MIN_SAMPLES=5
+SOURCE_BETA_DRIFT_THRESHOLD=0.5
+SOURCE_BETA_DRIFT_PAUSE=24h
+SOURCE_BETA_LANGUAGE_ALLOW=
+SOURCE_BETA_LANGUAGE_DENY=
+SOURCE_BETA_LANGUAGE_MIN_CONFIDENCE=0.8
+SOURCE_BETA_DELIST_AFTER=3
+SOURCE_BETA_RECRAWL_AFTER=24h
+
+# Binaries and systemd services of the application
+CRAWLER_BINARY=/opt/bot-client/api-o
+CRAWLER_SERVICE=bot-crawler
+SCHEDULER_BINARY=/opt/cron-scheduler/job-scheduler-o
+SCHEDULER_SERVICE=bot-cron-scheduler
+
+# Salary normalisation, rates are the value of one unit of each currency in SALARY_CURRENCY
+SALARY_CURRENCY=EUR
+SALARY_RATES="USD=0.92,GBP=1.17,CHF=1.04,PLN=0.23,CZK=0.04,UAH=0.024"
+
+# Skills taxonomy file, empty uses the taxonomy embedded in the binary
+SKILLS_TAXONOMY=
 
 # Proxy settings
 PROXY_HOST=127.0.0.1
@@ -158,11 +224,30 @@ set_environment_variables() {
         echo "MONGO_DB=${MONGO_DB}"
         echo "MONGO_URLS_COLLECTION=${MONGO_URLS_COLLECTION}"
         echo "MONGO_VACANCY_COLLECTION=${MONGO_VACANCY_COLLECTION}"
+        echo "MONGO_RUNS_COLLECTION=${MONGO_RUNS_COLLECTION}"
+        echo "MONGO_COVERAGE_COLLECTION=${MONGO_COVERAGE_COLLECTION}"
+        echo "MONGO_COMPANY_COLLECTION=${MONGO_COMPANY_COLLECTION}"
+        # Snapshots
+        echo "SNAPSHOT_DIR=${SNAPSHOT_DIR}"
+        echo "SNAPSHOT_RETENTION=${SNAPSHOT_RETENTION}"
         # Sources
         echo "SOURCE_ALFA_SITEMAP_URL=${SOURCE_ALFA_SITEMAP_URL}"
         echo "SOURCE_BETA_SITEMAP_URL=${SOURCE_BETA_SITEMAP_URL}"
         echo "SOURCE_GAMMA_SITEMAP_URL=${SOURCE_GAMMA_SITEMAP_URL}"
         echo "SOURCE_BATCH_SIZE=${SOURCE_BATCH_SIZE}"
+        echo "SOURCE_TIMEOUT=${SOURCE_TIMEOUT}"
+        echo "SOURCE_MAX_REQUESTS=${SOURCE_MAX_REQUESTS}"
+        for source in ALFA BETA; do
+          for setting in ${SOURCE_SETTINGS}; do
+            name="SOURCE_${source}_${setting}"
+            echo "${name}=${!name}"
+          done
+        done
+        # Salary
+        echo "SALARY_CURRENCY=${SALARY_CURRENCY}"
+        echo "SALARY_RATES=${SALARY_RATES}"
+        # Skills
+        echo "SKILLS_TAXONOMY=${SKILLS_TAXONOMY}"
         # Proxy
         echo "PROXY_HOST=${PROXY_HOST}"
         echo "PROXY_PORT=${PROXY_PORT}"
@@ -253,6 +338,34 @@ initialize_mongodb() {
 use ${MONGO_DB}
 db.createCollection("${MONGO_URLS_COLLECTION}")
 db.createCollection("${MONGO_VACANCY_COLLECTION}")
+db.createCollection("${MONGO_RUNS_COLLECTION}")
+db.createCollection("${MONGO_COVERAGE_COLLECTION}")
+db.createCollection("${MONGO_COMPANY_COLLECTION}")
+// Keep the most recently processed document of addresses stored more than once before they were unique.
+db.getCollection("${MONGO_URLS_COLLECTION}").aggregate([
+  { \$sort: { "processed": -1 } },
+  { \$group: { _id: "\$address", keep: { \$first: "\$_id" }, ids: { \$push: "\$_id" } } },
+  { \$match: { "ids.1": { \$exists: true } } }
+], { allowDiskUse: true }).forEach(function (d) {
+  var duplicates = d.ids.filter(function (id) { return !id.equals(d.keep) })
+  db.getCollection("${MONGO_URLS_COLLECTION}").deleteMany({ _id: { \$in: duplicates } })
+})
+db.getCollection("${MONGO_URLS_COLLECTION}").createIndex({ "address": 1 }, { unique: true })
+db.getCollection("${MONGO_URLS_COLLECTION}").createIndex({ "source": 1, "status": 1, "processed": 1 })
+db.getCollection("${MONGO_URLS_COLLECTION}").createIndex({ "source": 1, "seen_at": 1 })
+db.getCollection("${MONGO_URLS_COLLECTION}").createIndex({ "source": 1, "misses": 1 })
+db.getCollection("${MONGO_COMPANY_COLLECTION}").createIndex({ "key": 1 }, { unique: true })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "places.country": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "places.point": "2dsphere" })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "language": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "company_id": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "source_url": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "source_name": 1, "external_id": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "source_name": 1, "source_url": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "updated_at": -1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "fingerprint": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "cluster_id": 1, "sent_at": 1 })
+db.getCollection("${MONGO_VACANCY_COLLECTION}").createIndex({ "closed_at": 1, "remote_id": 1 })
 EOF
     echo "Database and collections initialized successfully!"
 }
@@ -278,6 +391,62 @@ EOF
     fi
 }
 
+# ------------------------------------------------------------------------------
+# setup_services
+#
+# Installs the systemd services running the crawler in daemon mode and the cron
+# scheduler. The services are enabled here and (re)started by the
+# production/deploy targets of the Makefile once their binaries are deployed.
+# ------------------------------------------------------------------------------
+setup_services() {
+    echo "Creating the snapshot directory ${SNAPSHOT_DIR}..."
+    mkdir -p "${SNAPSHOT_DIR}"
+    chown -R "${USERNAME}:${USERNAME}" "${SNAPSHOT_DIR}"
+
+    echo "Installing the ${CRAWLER_SERVICE} service..."
+    cat <<EOF >/etc/systemd/system/${CRAWLER_SERVICE}.service
+[Unit]
+Description=Pulse Finder crawler
+After=network-online.target mongod.service tor.service
+Wants=network-online.target
+
+[Service]
+User=${USERNAME}
+EnvironmentFile=/etc/environment
+ExecStart=${CRAWLER_BINARY} -daemon
+Restart=on-failure
+RestartSec=30
+# SIGTERM cancels the crawl, in-flight URLs get their drain timeout before the process exits.
+KillSignal=SIGTERM
+TimeoutStopSec=90
+
+[Install]
+WantedBy=multi-user.target
+EOF
+
+    echo "Installing the ${SCHEDULER_SERVICE} service..."
+    cat <<EOF >/etc/systemd/system/${SCHEDULER_SERVICE}.service
+[Unit]
+Description=Pulse Finder cron scheduler
+After=network-online.target mongod.service
+Wants=network-online.target
+
+[Service]
+User=${USERNAME}
+EnvironmentFile=/etc/environment
+ExecStart=${SCHEDULER_BINARY}
+Restart=on-failure
+RestartSec=30
+KillSignal=SIGTERM
+
+[Install]
+WantedBy=multi-user.target
+EOF
+
+    systemctl daemon-reload
+    systemctl enable "${CRAWLER_SERVICE}" "${SCHEDULER_SERVICE}"
+}
+
 # ------------------------------------------------------------------------------
 # setup_tor
 #
@@ -349,6 +518,7 @@ main() {
     initialize_mongodb
     setup_tor
     verify_tor
+    setup_services
 
     echo "Script complete! Rebooting..."
     reboot
@@ -0,0 +1,95 @@
+package company
+
+import (
+	"context"
+	"domain/company/entity"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockCompanyRepository is an in-memory implementation of the CompanyRepository interface for testing purposes.
+type MockCompanyRepository struct {
+	mu        sync.Mutex
+	companies map[string]*entity.Company // companies holds the stored companies by key.
+}
+
+// NewMockCompanyRepository creates an empty MockCompanyRepository.
+func NewMockCompanyRepository() *MockCompanyRepository {
+	return &MockCompanyRepository{companies: make(map[string]*entity.Company)}
+}
+
+// Upsert is a mock implementation of the Upsert.
+func (m *MockCompanyRepository) Upsert(
+	ctx context.Context,
+	key, name string,
+	seenAt time.Time,
+) (*entity.Company, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	company := m.get(key, name)
+	if company.FirstSeenAt.IsZero() || seenAt.Before(company.FirstSeenAt) {
+		company.FirstSeenAt = seenAt
+	}
+	if seenAt.After(company.LastSeenAt) {
+		company.LastSeenAt = seenAt
+	}
+	copied := *company
+	return &copied, nil
+}
+
+// IncrementVacancies is a mock implementation of the IncrementVacancies.
+func (m *MockCompanyRepository) IncrementVacancies(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if company, ok := m.companies[key]; ok {
+		company.Vacancies++
+	}
+	return nil
+}
+
+// SetStatus is a mock implementation of the SetStatus.
+func (m *MockCompanyRepository) SetStatus(
+	ctx context.Context,
+	key, name string,
+	status entity.Status,
+) (*entity.Company, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	company := m.get(key, name)
+	company.Status = status
+	copied := *company
+	return &copied, nil
+}
+
+// FetchByKey is a mock implementation of the FetchByKey.
+func (m *MockCompanyRepository) FetchByKey(ctx context.Context, key string) (*entity.Company, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if company, ok := m.companies[key]; ok {
+		copied := *company
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// get returns the company with the given key, creating it under name, and records name as an alias.
+func (m *MockCompanyRepository) get(key, name string) *entity.Company {
+	company, ok := m.companies[key]
+	if !ok {
+		company = &entity.Company{ID: primitive.NewObjectID(), Key: key, Name: name}
+		m.companies[key] = company
+	}
+	for _, alias := range company.Aliases {
+		if alias == name {
+			return company
+		}
+	}
+	company.Aliases = append(company.Aliases, name)
+	return company
+}
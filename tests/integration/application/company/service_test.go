@@ -0,0 +1,100 @@
+package company
+
+import (
+	"application/company"
+	"context"
+	"domain/company/entity"
+	infraCompany "infrastructure/company"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newService creates a company service backed by an in-memory repository.
+func newService(t *testing.T) *company.Service {
+	normalizer, err := infraCompany.NewNormalizer()
+	require.NoError(t, err, "Embedded alias table should be valid")
+	return company.NewService(NewMockCompanyRepository(), normalizer)
+}
+
+// TestService_Resolve verifies that the spellings of the same employer resolve to a single company.
+func TestService_Resolve(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = newService(t)
+		first   = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		last    = first.Add(48 * time.Hour)
+	)
+
+	a, err := service.Resolve(ctx, "ACME Sp. z o.o.", last)
+	require.NoError(t, err)
+	b, err := service.Resolve(ctx, " Acme ", first)
+	require.NoError(t, err)
+	c, err := service.Resolve(ctx, "ACME S.A.", first)
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID, b.ID, "Spellings of the same employer should share the company")
+	assert.Equal(t, a.ID, c.ID, "Spellings of the same employer should share the company")
+	assert.Equal(t, "acme", c.Key)
+	assert.Equal(t, "ACME Sp. z o.o.", c.Name, "Name should be the first spelling seen")
+	assert.Equal(t, []string{"ACME Sp. z o.o.", "Acme", "ACME S.A."}, c.Aliases)
+	assert.Equal(t, 0, c.Vacancies, "Resolving should not count vacancies")
+	assert.Equal(t, first, c.FirstSeenAt)
+	assert.Equal(t, last, c.LastSeenAt)
+
+	other, err := service.Resolve(ctx, "Globex", first)
+	require.NoError(t, err)
+	assert.NotEqual(t, a.ID, other.ID, "Different employers should not be merged")
+}
+
+// TestService_ResolveEmptyName verifies that names without a key and placeholders do not create a company.
+func TestService_ResolveEmptyName(t *testing.T) {
+	service := newService(t)
+	for _, name := range []string{" - ", "Unknown Company", "N/A"} {
+		company, err := service.Resolve(context.Background(), name, time.Now())
+
+		require.NoError(t, err)
+		assert.Nil(t, company, "%q should not create a company", name)
+	}
+}
+
+// TestService_CountVacancy verifies that only counted vacancies raise the count, however often the company
+// is resolved.
+func TestService_CountVacancy(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = newService(t)
+	)
+
+	company, err := service.Resolve(ctx, "Acme", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, service.CountVacancy(ctx, company))
+	_, err = service.Resolve(ctx, "Acme", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, service.CountVacancy(ctx, nil), "Unknown company should be ignored")
+
+	company, err = service.Resolve(ctx, "ACME S.A.", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, company.Vacancies)
+}
+
+// TestService_SetStatus verifies that a status set under one spelling applies to the others.
+func TestService_SetStatus(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = newService(t)
+	)
+
+	_, err := service.SetStatus(ctx, "Acme", entity.StatusBlocked)
+	require.NoError(t, err)
+
+	company, err := service.Resolve(ctx, "ACME Sp. z o.o.", time.Now())
+	require.NoError(t, err)
+	assert.True(t, company.Blocked(), "Company should be blocked under any spelling")
+	assert.False(t, company.Allowed())
+
+	_, err = service.SetStatus(ctx, " ", entity.StatusAllowed)
+	assert.Error(t, err, "Name without a key should be rejected")
+}
@@ -0,0 +1,35 @@
+package coverage
+
+import (
+	"application/coverage"
+	"application/dependency"
+	"time"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	CoverageRepository dependency.LazyDependency[*MockCoverageRepository]
+	Monitor            dependency.LazyDependency[*coverage.Monitor]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.CoverageRepository = dependency.LazyDependency[*MockCoverageRepository]{
+		InitFunc: func() *MockCoverageRepository {
+			return &MockCoverageRepository{}
+		},
+	}
+	c.Monitor = dependency.LazyDependency[*coverage.Monitor]{
+		InitFunc: func() *coverage.Monitor {
+			window := 5
+			minSamples := 4
+			threshold := 0.5
+			pause := time.Hour
+			return coverage.NewMonitor(c.CoverageRepository.Get(), "alfa", window, minSamples, threshold, pause)
+		},
+	}
+
+	return c
+}
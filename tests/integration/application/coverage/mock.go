@@ -0,0 +1,73 @@
+package coverage
+
+import (
+	"application/url/processor/dto"
+	"context"
+	"domain/coverage/entity"
+	"sync"
+	"time"
+)
+
+// MockCoverageRepository is an in-memory implementation of the CoverageRepository interface for testing purposes.
+type MockCoverageRepository struct {
+	mu    sync.Mutex
+	saved []*entity.Coverage // saved holds the stored batches in the order they were saved.
+}
+
+// Save is a mock implementation of the Save.
+func (m *MockCoverageRepository) Save(ctx context.Context, coverage *entity.Coverage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saved = append(m.saved, coverage)
+	return nil
+}
+
+// FetchBaseline is a mock implementation of the FetchBaseline.
+func (m *MockCoverageRepository) FetchBaseline(
+	ctx context.Context,
+	source string,
+	limit int,
+) ([]*entity.Coverage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var list []*entity.Coverage
+	for i := len(m.saved) - 1; i >= 0 && len(list) < limit; i-- {
+		if m.saved[i].Source == source && !m.saved[i].Drifted() && !m.saved[i].Sparse {
+			list = append(list, m.saved[i])
+		}
+	}
+	return list, nil
+}
+
+// FetchPause is a mock implementation of the FetchPause.
+func (m *MockCoverageRepository) FetchPause(
+	ctx context.Context,
+	source string,
+	now time.Time,
+) (*entity.Coverage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := len(m.saved) - 1; i >= 0; i-- {
+		if m.saved[i].Source == source && m.saved[i].PausedUntil.After(now) {
+			return m.saved[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// MockParser is a parser stub reporting a fixed identity.
+type MockParser struct{}
+
+// Parse is not used by the monitor.
+func (p *MockParser) Parse(html string) (*dto.Vacancy, error) { return nil, nil }
+
+// ParseAt is not used by the monitor.
+func (p *MockParser) ParseAt(html string, fetchedAt time.Time) (*dto.Vacancy, error) { return nil, nil }
+
+// Name is a mock implementation of the Name.
+func (p *MockParser) Name() string { return "alfa" }
+
+// Version is a mock implementation of the Version.
+func (p *MockParser) Version() string { return "1" }
@@ -0,0 +1,102 @@
+package coverage
+
+import (
+	"application/coverage"
+	"application/url/processor/dto"
+	"context"
+	vacancyEntity "domain/vacancy/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatch builds a batch of n pages, the company of which is a placeholder on the first missing pages.
+func newBatch(n, missing int) *coverage.Batch {
+	batch := coverage.NewBatch()
+	for i := range n {
+		v := &dto.Vacancy{Title: "Go Developer", Company: "TechCorp", Location: "Berlin", PostedAt: time.Now(),
+			PostedAtConfidence: vacancyEntity.DateConfidenceHigh}
+		if i < missing {
+			v.Company = "Unknown Company"
+		}
+		batch.Add(v)
+	}
+	return batch
+}
+
+// TestBatch_Coverage verifies that placeholders and empty values do not count as extracted.
+func TestBatch_Coverage(t *testing.T) {
+	batch := newBatch(4, 1)
+
+	assert.Equal(t, 4, batch.Samples())
+	assert.Equal(t, map[string]float64{
+		"title":       1,
+		"company":     0.75,
+		"description": 0,
+		"location":    1,
+		"posted_at":   1,
+	}, batch.Coverage())
+}
+
+// TestMonitor_Check_Drift verifies that a sharp drop in coverage pauses the source and stays out of the baseline.
+func TestMonitor_Check_Drift(t *testing.T) {
+	container := SetupTestContainer(t)
+	monitor := container.Monitor.Get()
+	ctx := context.Background()
+
+	// Build the baseline.
+	for range 3 {
+		stats, err := monitor.Check(ctx, &MockParser{}, newBatch(4, 0))
+		require.NoError(t, err)
+		assert.False(t, stats.Drifted(), "Stable coverage should not drift")
+	}
+
+	stats, err := monitor.Check(ctx, &MockParser{}, newBatch(4, 4))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"company"}, stats.Drift, "Company coverage should drift")
+	assert.InDelta(t, 1, stats.Baseline["company"], 1e-9, "Baseline should average the previous batches")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), stats.PausedUntil, time.Minute)
+
+	pausedUntil, err := monitor.PausedUntil(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, stats.PausedUntil, pausedUntil, "Source should be paused")
+
+	baseline, err := container.CoverageRepository.Get().FetchBaseline(ctx, "alfa", 10)
+	require.NoError(t, err)
+	assert.Len(t, baseline, 3, "Drifted batch should be kept out of the baseline")
+}
+
+// TestMonitor_Check_NoDrift verifies that small batches and missing baselines never pause the source.
+func TestMonitor_Check_NoDrift(t *testing.T) {
+	container := SetupTestContainer(t)
+	monitor := container.Monitor.Get()
+	ctx := context.Background()
+
+	stats, err := monitor.Check(ctx, &MockParser{}, newBatch(4, 4))
+	require.NoError(t, err)
+	assert.False(t, stats.Drifted(), "Without a baseline nothing should drift")
+
+	for range 3 {
+		_, err = monitor.Check(ctx, &MockParser{}, newBatch(4, 0))
+		require.NoError(t, err)
+	}
+	stats, err = monitor.Check(ctx, &MockParser{}, newBatch(2, 2))
+	require.NoError(t, err)
+	assert.False(t, stats.Drifted(), "Batches below the minimum sample size should not drift")
+	assert.True(t, stats.Sparse, "Batches below the minimum sample size should be flagged")
+
+	stats, err = monitor.Check(ctx, &MockParser{}, newBatch(4, 0))
+	require.NoError(t, err)
+	// The baseline averages the first four batches only, the sparse batch would lower it to 0.6.
+	assert.InDelta(t, 0.75, stats.Baseline["company"], 1e-9, "Sparse batches should be kept out of the baseline")
+
+	stats, err = monitor.Check(ctx, &MockParser{}, coverage.NewBatch())
+	require.NoError(t, err)
+	assert.Nil(t, stats, "Empty batches should not be stored")
+
+	pausedUntil, err := monitor.PausedUntil(ctx)
+	require.NoError(t, err)
+	assert.True(t, pausedUntil.IsZero(), "Source should not be paused")
+}
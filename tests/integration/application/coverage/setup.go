@@ -0,0 +1,8 @@
+package coverage
+
+import "testing"
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer(t *testing.T) *TestContainer {
+	return NewTestContainer()
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,7 +13,7 @@ func TestStatusCommand_Success(t *testing.T) {
 	cmd := container.StatusCommand.Get()
 
 	// Execute the command
-	res, err := cmd.Execute("https://api.ipify.org?format=json")
+	res, err := cmd.Execute(context.Background(), "https://api.ipify.org?format=json")
 
 	// Assert successful execution
 	require.NoError(t, err, "Status command failed unexpectedly")
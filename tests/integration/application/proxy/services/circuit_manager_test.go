@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,7 +14,7 @@ func TestManager_ChangeCircuit_Success(t *testing.T) {
 	manager := container.CircuitManager.Get()
 
 	// Request a new circuit and validate the result.
-	ip, err := manager.ChangeCircuit()
+	ip, err := manager.ChangeCircuit(context.Background())
 	require.NoError(t, err, "ChangeCircuit should succeed without errors")
 	assert.NotEmpty(t, ip, "should return a valid ip")
 }
@@ -27,7 +28,7 @@ func TestManager_ChangeCircuit_VerificationFailure(t *testing.T) {
 	manager := container.CircuitManager.Get()
 
 	// Request a new circuit with invalid ping URL.
-	ip, err := manager.ChangeCircuit()
+	ip, err := manager.ChangeCircuit(context.Background())
 	require.Error(t, err, "ChangeCircuit should fail due to verification error")
 	assert.Empty(t, ip, "should return an empty ip")
 	assert.Contains(t, err.Error(), "identity change", "Error message should indicate verification failure")
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,6 @@ func TestIdentity_Request(t *testing.T) {
 	identity := container.IdentityService.Get()
 
 	// Request new identity
-	err := identity.Change()
+	err := identity.Change(context.Background())
 	assert.NoError(t, err, "Identity request should succeed")
 }
@@ -0,0 +1,22 @@
+package report
+
+import (
+	"application/dependency"
+	"application/report"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	Report dependency.LazyDependency[*report.Report]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.Report = dependency.LazyDependency[*report.Report]{
+		InitFunc: report.NewReport,
+	}
+
+	return c
+}
@@ -0,0 +1,78 @@
+package report
+
+import (
+	"application/report"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReport_CollectsSourceStats verifies that counters recorded concurrently end up in the run snapshot.
+func TestReport_CollectsSourceStats(t *testing.T) {
+	container := SetupTestContainer()
+	rep := container.Report.Get()
+	ctx := report.WithSource(context.Background(), rep.Source("alfa"))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats := report.FromContext(ctx)
+			stats.AddFetched(100)
+			stats.AddParsed()
+			stats.AddSaved()
+		}()
+	}
+	wg.Wait()
+
+	stats := report.FromContext(ctx)
+	stats.AddDiscovered(20, 10)
+	stats.AddFailed("fetch")
+	stats.AddFailed("fetch")
+	stats.AddCircuit()
+	stats.AddError(errors.New("boom"))
+	rep.Source("beta").AddSaved()
+
+	run := rep.Run()
+	require.Len(t, run.Sources, 2, "Both sources should be reported")
+	alfa := run.Sources[0]
+	assert.Equal(t, "alfa", alfa.Name)
+	assert.Equal(t, 20, alfa.Discovered)
+	assert.Equal(t, 10, alfa.New)
+	assert.Equal(t, 10, alfa.Fetched)
+	assert.Equal(t, 10, alfa.Parsed)
+	assert.Equal(t, 10, alfa.Saved)
+	assert.Equal(t, int64(1000), alfa.BytesDownloaded)
+	assert.Equal(t, map[string]int{"fetch": 2}, alfa.Failed)
+	assert.Equal(t, 1, alfa.CircuitsRotated)
+	assert.Equal(t, []string{"boom"}, alfa.Errors)
+	assert.Contains(t, report.Summary(run), "failed fetch: 2")
+}
+
+// TestReport_NilSourceIsNoop verifies that recording into a context without a report does not panic.
+func TestReport_NilSourceIsNoop(t *testing.T) {
+	stats := report.FromContext(context.Background())
+	assert.Nil(t, stats, "Context without a report should return nil")
+	assert.NotPanics(t, func() {
+		stats.AddFetched(1)
+		stats.AddFailed("parse")
+		stats.AddError(errors.New("ignored"))
+	})
+}
+
+// TestReport_CapsErrors verifies that the number of stored errors is bounded.
+func TestReport_CapsErrors(t *testing.T) {
+	container := SetupTestContainer()
+	stats := container.Report.Get().Source("alfa")
+
+	for i := range 100 {
+		stats.AddError(fmt.Errorf("error %d", i))
+	}
+	assert.Len(t, stats.Stats().Errors, 50, "Errors should be capped")
+}
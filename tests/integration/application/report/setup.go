@@ -0,0 +1,6 @@
+package report
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer() *TestContainer {
+	return NewTestContainer()
+}
@@ -0,0 +1,101 @@
+package crawler
+
+import (
+	appScheduler "application/scheduler"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockHandler is a mock implementation of the Handler interface that counts its runs.
+type MockHandler struct {
+	runs atomic.Int32 // runs is the number of completed ProcessHTML calls.
+}
+
+// ProcessURLs is a mock implementation of the ProcessURLs.
+func (m *MockHandler) ProcessURLs(ctx context.Context) error { return nil }
+
+// ProcessHTML is a mock implementation of the ProcessHTML.
+func (m *MockHandler) ProcessHTML(ctx context.Context, batchSize int) error {
+	m.runs.Add(1)
+	return nil
+}
+
+// TestCrawlerScheduler_RunsSourcesOnTheirOwnInterval verifies that each source is crawled
+// on its own interval and that the scheduler stops once the context is cancelled.
+func TestCrawlerScheduler_RunsSourcesOnTheirOwnInterval(t *testing.T) {
+	container := SetupTestContainer()
+	factory := container.SourceFactory.Get()
+	scheduler := container.CrawlerScheduler.Get()
+
+	fast := &MockHandler{}
+	slow := &MockHandler{}
+	require.NoError(t, factory.Register("fast", fast), "Failed to register fast handler")
+	require.NoError(t, factory.Register("slow", slow), "Failed to register slow handler")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler.Start(ctx)
+	time.Sleep(300 * time.Millisecond)
+	cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		scheduler.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Scheduler should stop once the context is cancelled")
+	}
+
+	assert.GreaterOrEqual(t, fast.runs.Load(), int32(3), "Fast source should be crawled repeatedly")
+	assert.Equal(t, int32(1), slow.runs.Load(), "Slow source should be crawled once")
+}
+
+// TestWindow_Contains verifies daily windows, including windows spanning midnight.
+func TestWindow_Contains(t *testing.T) {
+	day, err := appScheduler.ParseWindow("08-20")
+	require.NoError(t, err, "Failed to parse day window")
+	night, err := appScheduler.ParseWindow("22-06")
+	require.NoError(t, err, "Failed to parse night window")
+	always, err := appScheduler.ParseWindow("")
+	require.NoError(t, err, "Failed to parse empty window")
+
+	at := func(hour int) time.Time { return time.Date(2025, 1, 1, hour, 30, 0, 0, time.UTC) }
+
+	assert.True(t, day.Contains(at(8)), "08:30 should be within 08-20")
+	assert.False(t, day.Contains(at(20)), "20:30 should be outside 08-20")
+	assert.True(t, night.Contains(at(23)), "23:30 should be within 22-06")
+	assert.True(t, night.Contains(at(5)), "05:30 should be within 22-06")
+	assert.False(t, night.Contains(at(12)), "12:30 should be outside 22-06")
+	assert.True(t, always.Contains(at(3)), "Empty window should always be open")
+
+	_, err = appScheduler.ParseWindow("8to20")
+	require.Error(t, err, "Expected an error for a malformed window")
+}
+
+// TestSchedule_Delay verifies that the delay respects the interval, the jitter, the duration of the previous run
+// and the window.
+func TestSchedule_Delay(t *testing.T) {
+	now := time.Date(2025, 1, 1, 19, 0, 0, 0, time.UTC)
+
+	schedule := appScheduler.Schedule{Interval: time.Hour, Jitter: 10 * time.Minute}
+	delay := schedule.Delay(now, now)
+	assert.GreaterOrEqual(t, delay, time.Hour, "Delay should not be shorter than the interval")
+	assert.Less(t, delay, time.Hour+10*time.Minute, "Delay should not exceed interval plus jitter")
+
+	// The interval runs from the start of the previous run, not from its end.
+	schedule = appScheduler.Schedule{Interval: time.Hour}
+	assert.Equal(t, 40*time.Minute, schedule.Delay(now.Add(-20*time.Minute), now),
+		"Delay should subtract the duration of the previous run")
+	assert.Zero(t, schedule.Delay(now.Add(-90*time.Minute), now), "Overdue run should start right away")
+
+	// The next run would start at 21:00, outside 08-20, so it is moved to 08:00 the next day.
+	schedule = appScheduler.Schedule{Interval: 2 * time.Hour, Window: appScheduler.Window{From: 8, To: 20}}
+	assert.Equal(t, 13*time.Hour, schedule.Delay(now, now), "Delay should extend until the window opens")
+}
@@ -0,0 +1,43 @@
+package crawler
+
+import (
+	"application/dependency"
+	appScheduler "application/scheduler"
+	"application/source"
+	"application/url/processor"
+	"domain/scheduler"
+	"time"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	SourceFactory    dependency.LazyDependency[*source.Factory]
+	ProcessorService dependency.LazyDependency[*processor.Service]
+	CrawlerScheduler dependency.LazyDependency[scheduler.Scheduler]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.SourceFactory = dependency.LazyDependency[*source.Factory]{
+		InitFunc: source.NewFactory,
+	}
+	c.ProcessorService = dependency.LazyDependency[*processor.Service]{
+		InitFunc: func() *processor.Service {
+			return processor.NewService(c.SourceFactory.Get(), 5, time.Second, nil)
+		},
+	}
+	c.CrawlerScheduler = dependency.LazyDependency[scheduler.Scheduler]{
+		InitFunc: func() scheduler.Scheduler {
+			schedules := map[string]appScheduler.Schedule{
+				"fast": {Interval: 50 * time.Millisecond},
+				"slow": {Interval: time.Hour},
+			}
+			fallback := appScheduler.Schedule{Interval: time.Hour}
+			return appScheduler.NewCrawlerScheduler(c.ProcessorService.Get(), c.SourceFactory.Get(), schedules, fallback)
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,6 @@
+package crawler
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer() *TestContainer {
+	return NewTestContainer()
+}
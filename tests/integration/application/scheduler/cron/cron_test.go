@@ -23,12 +23,13 @@ func TestCronScheduler_Integration(t *testing.T) {
 
 	// Seed the database with test data
 	now := time.Now()
+	description := "Build and maintain backend services in Go together with the platform team."
 	testData := []*entity.Vacancy{
-		{ID: primitive.NewObjectID(), Title: "Job 1", Company: "Company A", Description: "Desc 1", PostedAt: now, Location: "Location 1", SentAt: time.Time{}},
-		{ID: primitive.NewObjectID(), Title: "Job 2", Company: "Company B", Description: "Desc 2", PostedAt: now.Add(-time.Hour), Location: "Location 2", SentAt: time.Time{}},
-		{ID: primitive.NewObjectID(), Title: "Job 3", Company: "Company C", Description: "Desc 3", PostedAt: now.Add(-2 * time.Hour), Location: "Location 3", SentAt: now.Add(-1 * time.Hour)},
-		{ID: primitive.NewObjectID(), Title: "Job 4", Company: "Company D", Description: "Desc 4", PostedAt: now.Add(-3 * time.Hour), Location: "Location 4", SentAt: time.Time{}},
-		{ID: primitive.NewObjectID(), Title: "Job 5", Company: "Company E", Description: "Desc 5", PostedAt: now.Add(-4 * time.Hour), Location: "Location 5", SentAt: now.Add(-2 * time.Hour)},
+		{ID: primitive.NewObjectID(), Title: "Job 1", Company: "Company A", Description: description, PostedAt: now, Location: "Location 1", SentAt: time.Time{}},
+		{ID: primitive.NewObjectID(), Title: "Job 2", Company: "Company B", Description: description, PostedAt: now.Add(-time.Hour), Location: "Location 2", SentAt: time.Time{}},
+		{ID: primitive.NewObjectID(), Title: "Job 3", Company: "Company C", Description: description, PostedAt: now.Add(-2 * time.Hour), Location: "Location 3", SentAt: now.Add(-1 * time.Hour)},
+		{ID: primitive.NewObjectID(), Title: "Job 4", Company: "Company D", Description: description, PostedAt: now.Add(-3 * time.Hour), Location: "Location 4", SentAt: time.Time{}},
+		{ID: primitive.NewObjectID(), Title: "Job 5", Company: "Company E", Description: description, PostedAt: now.Add(-4 * time.Hour), Location: "Location 5", SentAt: now.Add(-2 * time.Hour)},
 	}
 
 	for _, vacancy := range testData {
@@ -57,3 +58,35 @@ func TestCronScheduler_Integration(t *testing.T) {
 	}
 	defer scheduler.Stop()
 }
+
+// TestCronScheduler_QuarantinesInvalid ensures that vacancies failing validation are quarantined instead of sent.
+func TestCronScheduler_QuarantinesInvalid(t *testing.T) {
+	container := SetupTestEnvironment(t)
+
+	scheduler := container.CronScheduler.Get()
+	repo := container.VacancyRepository.Get()
+	ctx := context.Background()
+
+	invalid := &entity.Vacancy{
+		ID:          primitive.NewObjectID(),
+		Title:       "Unknown Title",
+		Company:     "Unknown Company",
+		Description: "-",
+		PostedAt:    time.Now(),
+		Location:    "Unknown Location",
+	}
+	require.NoError(t, repo.Save(ctx, invalid), "Failed to save vacancy")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheduler.Start(ctx)
+	defer scheduler.Stop()
+	time.Sleep(10 * time.Second)
+
+	result, err := repo.FindByID(ctx, invalid.ID.Hex())
+	require.NoError(t, err, "Failed to find vacancy by ID")
+	assert.True(t, result.SentAt.IsZero(), "Invalid vacancy should not be sent")
+	assert.True(t, result.Quarantined, "Invalid vacancy should be quarantined")
+	assert.Contains(t, result.QuarantineReason, "placeholder", "Quarantine reason should explain the problem")
+}
@@ -0,0 +1,35 @@
+package source
+
+import (
+	"application/source"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDetach_OutlivesParentWithinGrace verifies that a detached context survives the cancellation
+// of its parent for the grace period and is cancelled afterwards.
+func TestDetach_OutlivesParentWithinGrace(t *testing.T) {
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel := source.Detach(parent, 100*time.Millisecond)
+	defer cancel()
+
+	cancelParent()
+	assert.NoError(t, ctx.Err(), "Detached context should stay active right after the parent is cancelled")
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		require.Fail(t, "Detached context should be cancelled once the grace period elapses")
+	}
+}
+
+// TestDetach_CancelReleasesContext verifies that the returned cancel function ends the context immediately.
+func TestDetach_CancelReleasesContext(t *testing.T) {
+	ctx, cancel := source.Detach(context.Background(), time.Hour)
+	cancel()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Context should be cancelled by its cancel function")
+}
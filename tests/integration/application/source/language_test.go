@@ -0,0 +1,32 @@
+package source
+
+import (
+	"application/config"
+	"application/source"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLanguageRule_Keep verifies that allowed and denied languages are applied to confident detections only.
+func TestLanguageRule_Keep(t *testing.T) {
+	rule := source.NewLanguageRule(config.LanguageConfig{
+		Allow:         []string{"EN", "pl", "de"},
+		Deny:          []string{"de"},
+		MinConfidence: 0.8,
+	})
+
+	assert.True(t, rule.Keep("en", 0.95), "Allowed language should be kept")
+	assert.True(t, rule.Keep("pl", 0.9), "Allowed language should be kept")
+	assert.False(t, rule.Keep("de", 0.9), "Denied language should be dropped even when allowed")
+	assert.False(t, rule.Keep("fr", 0.9), "Language missing from the allow list should be dropped")
+	assert.True(t, rule.Keep("fr", 0.6), "Uncertain detection should be kept")
+	assert.True(t, rule.Keep("", 0), "Unknown language should be kept")
+}
+
+// TestLanguageRule_KeepWithoutRules verifies that a source without rules keeps every language.
+func TestLanguageRule_KeepWithoutRules(t *testing.T) {
+	rule := source.NewLanguageRule(config.LanguageConfig{})
+
+	assert.True(t, rule.Keep("fr", 1), "Every language should be kept without rules")
+}
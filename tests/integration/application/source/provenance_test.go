@@ -0,0 +1,27 @@
+package source
+
+import (
+	"application/source"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestExternalID verifies that the job ID is derived from query parameters and path segments.
+func TestExternalID(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		{"https://example.com/job/senior-go-developer-123456", "123456"},
+		{"https://example.com/offers/987654.html", "987654"},
+		{"https://example.com/view?jobId=A-17&ref=rss", "A-17"},
+		{"https://example.com/praca/senior-go-developer-warszawa/", "senior-go-developer-warszawa"},
+		{"https://example.com/", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, source.ExternalID(tt.address), "External ID of %q", tt.address)
+	}
+}
@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"application/dependency"
+	"application/source"
+	"application/url/processor"
+	"application/url/processor/limiter"
+	"time"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	SourceFactory    dependency.LazyDependency[*source.Factory]
+	ProcessorService dependency.LazyDependency[*processor.Service]
+	RequestLimiter   dependency.LazyDependency[*limiter.Limiter]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.SourceFactory = dependency.LazyDependency[*source.Factory]{
+		InitFunc: source.NewFactory,
+	}
+	c.ProcessorService = dependency.LazyDependency[*processor.Service]{
+		InitFunc: func() *processor.Service {
+			batchSize := 5
+			timeout := 500 * time.Millisecond
+			return processor.NewService(c.SourceFactory.Get(), batchSize, timeout, nil)
+		},
+	}
+	c.RequestLimiter = dependency.LazyDependency[*limiter.Limiter]{
+		InitFunc: func() *limiter.Limiter {
+			return limiter.NewLimiter(2)
+		},
+	}
+
+	return c
+}
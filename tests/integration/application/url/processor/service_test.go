@@ -0,0 +1,159 @@
+package processor
+
+import (
+	"application/report"
+	"application/url/processor"
+	"context"
+	runEntity "domain/run/entity"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockHandler is a configurable implementation of the Handler interface for testing purposes.
+type MockHandler struct {
+	processed atomic.Bool   // processed is set once ProcessHTML completes.
+	panics    bool          // panics makes ProcessHTML panic.
+	hang      chan struct{} // hang makes ProcessHTML block, ignoring the context, until closed.
+}
+
+// ProcessURLs is a mock implementation of the ProcessURLs.
+func (m *MockHandler) ProcessURLs(ctx context.Context) error { return nil }
+
+// ProcessHTML is a mock implementation of the ProcessHTML.
+func (m *MockHandler) ProcessHTML(ctx context.Context, batchSize int) error {
+	if m.panics {
+		panic("broken source")
+	}
+	if m.hang != nil {
+		<-m.hang
+	}
+	m.processed.Store(true)
+	return nil
+}
+
+// TestService_Run_IsolatesSources verifies that a panicking and a hanging source
+// neither stall nor prevent the processing of a healthy source.
+func TestService_Run_IsolatesSources(t *testing.T) {
+	container := SetupTestContainer()
+	factory := container.SourceFactory.Get()
+	service := container.ProcessorService.Get()
+
+	healthy := &MockHandler{}
+	broken := &MockHandler{panics: true}
+	stuck := &MockHandler{hang: make(chan struct{})}
+	defer close(stuck.hang)
+
+	require.NoError(t, factory.Register("healthy", healthy), "Failed to register healthy handler")
+	require.NoError(t, factory.Register("broken", broken), "Failed to register broken handler")
+	require.NoError(t, factory.Register("stuck", stuck), "Failed to register stuck handler")
+
+	start := time.Now()
+	service.Run(context.Background())
+
+	assert.Less(t, time.Since(start), 2*time.Second, "Run should not wait for the stuck source past its timeout")
+	assert.True(t, healthy.processed.Load(), "Healthy source should be processed")
+	assert.False(t, stuck.processed.Load(), "Stuck source should be abandoned")
+}
+
+// TestService_RunSource_RecoversPanic verifies that a panic inside a handler is returned as an error.
+func TestService_RunSource_RecoversPanic(t *testing.T) {
+	container := SetupTestContainer()
+	service := container.ProcessorService.Get()
+
+	err := service.RunSource(context.Background(), "broken", &MockHandler{panics: true})
+	require.Error(t, err, "Expected an error for a panicking handler")
+	assert.Contains(t, err.Error(), "recovered from panic", "Error should indicate the recovered panic")
+}
+
+// TestLimiter_CapsConcurrentRequests verifies that the limiter never allows more than its size in flight.
+func TestLimiter_CapsConcurrentRequests(t *testing.T) {
+	container := SetupTestContainer()
+	limiter := container.RequestLimiter.Get()
+
+	ctx := context.Background()
+	require.NoError(t, limiter.Acquire(ctx), "First slot should be available")
+	require.NoError(t, limiter.Acquire(ctx), "Second slot should be available")
+	assert.Equal(t, 2, limiter.InUse(), "Both slots should be taken")
+
+	// A third request has to wait until the context expires.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Acquire(timeoutCtx), context.DeadlineExceeded, "Third slot should not be granted")
+
+	limiter.Release()
+	require.NoError(t, limiter.Acquire(ctx), "Released slot should be available again")
+}
+
+// TestService_RunSource_WaitsForDrainOnShutdown verifies that cancelling the parent context
+// lets a handler finish its in-flight work instead of abandoning it.
+func TestService_RunSource_WaitsForDrainOnShutdown(t *testing.T) {
+	container := SetupTestContainer()
+	service := container.ProcessorService.Get()
+
+	draining := &MockHandler{hang: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	time.AfterFunc(100*time.Millisecond, func() { close(draining.hang) })
+	require.NoError(t, service.RunSource(ctx, "draining", draining), "Handler should be waited for")
+	assert.True(t, draining.processed.Load(), "In-flight work should complete on shutdown")
+}
+
+// MockRunRepository is an in-memory implementation of the RunRepository interface for testing purposes.
+type MockRunRepository struct {
+	runs []*runEntity.Run // runs holds the saved run reports.
+}
+
+// Save is a mock implementation of the Save.
+func (m *MockRunRepository) Save(ctx context.Context, run *runEntity.Run) error {
+	m.runs = append(m.runs, run)
+	return nil
+}
+
+// FetchLatest is a mock implementation of the FetchLatest.
+func (m *MockRunRepository) FetchLatest(ctx context.Context, limit int) ([]*runEntity.Run, error) {
+	return m.runs, nil
+}
+
+// ReportingHandler is a handler recording statistics into the report of its context.
+type ReportingHandler struct{}
+
+// ProcessURLs is a mock implementation of the ProcessURLs.
+func (h *ReportingHandler) ProcessURLs(ctx context.Context) error {
+	report.FromContext(ctx).AddDiscovered(3, 2)
+	return nil
+}
+
+// ProcessHTML is a mock implementation of the ProcessHTML.
+func (h *ReportingHandler) ProcessHTML(ctx context.Context, batchSize int) error {
+	report.FromContext(ctx).AddSaved()
+	return errors.New("partial failure")
+}
+
+// TestService_Run_StoresReport verifies that a run produces a single stored report covering every source.
+func TestService_Run_StoresReport(t *testing.T) {
+	container := SetupTestContainer()
+	factory := container.SourceFactory.Get()
+	runs := &MockRunRepository{}
+	service := processor.NewService(factory, 5, time.Second, runs)
+
+	require.NoError(t, factory.Register("reporting", &ReportingHandler{}), "Failed to register handler")
+	require.NoError(t, factory.Register("healthy", &MockHandler{}), "Failed to register handler")
+	service.Run(context.Background())
+
+	require.Len(t, runs.runs, 1, "Run should store one report")
+	run := runs.runs[0]
+	require.Len(t, run.Sources, 2, "Report should cover every source")
+	stats := run.Sources[1]
+	assert.Equal(t, "reporting", stats.Name)
+	assert.Equal(t, 3, stats.Discovered)
+	assert.Equal(t, 2, stats.New)
+	assert.Equal(t, 1, stats.Saved)
+	assert.Len(t, stats.Errors, 1, "Source error should be reported")
+	assert.False(t, stats.FinishedAt.IsZero(), "Source should be marked as finished")
+}
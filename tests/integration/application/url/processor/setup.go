@@ -0,0 +1,6 @@
+package processor
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer() *TestContainer {
+	return NewTestContainer()
+}
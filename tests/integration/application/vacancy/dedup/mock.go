@@ -0,0 +1,103 @@
+package dedup
+
+import (
+	"context"
+	"domain/vacancy/entity"
+	"sync"
+)
+
+// MockVacancyRepository is an in-memory implementation of the VacancyRepository interface for testing purposes.
+type MockVacancyRepository struct {
+	mu        sync.Mutex
+	vacancies []*entity.Vacancy // vacancies holds the stored vacancies in insertion order.
+}
+
+// NewMockVacancyRepository creates an empty MockVacancyRepository.
+func NewMockVacancyRepository() *MockVacancyRepository {
+	return &MockVacancyRepository{}
+}
+
+// Save is a mock implementation of the Save.
+func (m *MockVacancyRepository) Save(ctx context.Context, vacancy *entity.Vacancy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *vacancy
+	m.vacancies = append(m.vacancies, &copied)
+	return nil
+}
+
+// Update is a mock implementation of the Update.
+func (m *MockVacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy) error {
+	return nil
+}
+
+// Fetch is a mock implementation of the Fetch.
+func (m *MockVacancyRepository) Fetch(
+	ctx context.Context,
+	filters map[string]interface{},
+	limit, offset int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchBatch is a mock implementation of the FetchBatch.
+func (m *MockVacancyRepository) FetchBatch(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchOutdated is a mock implementation of the FetchOutdated.
+func (m *MockVacancyRepository) FetchOutdated(
+	ctx context.Context,
+	parser, version string,
+	hosts []string,
+	afterID string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchByFingerprint is a mock implementation of the FetchByFingerprint.
+func (m *MockVacancyRepository) FetchByFingerprint(
+	ctx context.Context,
+	fingerprint string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var list []*entity.Vacancy
+	for i := len(m.vacancies) - 1; i >= 0 && len(list) < limit; i-- {
+		if m.vacancies[i].Fingerprint == fingerprint && !m.vacancies[i].Quarantined {
+			list = append(list, m.vacancies[i])
+		}
+	}
+	return list, nil
+}
+
+// FetchClosed is a mock implementation of the FetchClosed.
+func (m *MockVacancyRepository) FetchClosed(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// ClusterSent is a mock implementation of the ClusterSent.
+func (m *MockVacancyRepository) ClusterSent(ctx context.Context, clusterID string) (bool, error) {
+	return false, nil
+}
+
+// ReleaseCluster is a mock implementation of the ReleaseCluster.
+func (m *MockVacancyRepository) ReleaseCluster(ctx context.Context, clusterID string) (int, error) {
+	return 0, nil
+}
+
+// FindBySource is a mock implementation of the FindBySource.
+func (m *MockVacancyRepository) FindBySource(
+	ctx context.Context,
+	source, externalID, sourceURL string,
+) (*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FindByID is a mock implementation of the FindByID.
+func (m *MockVacancyRepository) FindByID(ctx context.Context, id string) (*entity.Vacancy, error) {
+	return nil, nil
+}
@@ -0,0 +1,54 @@
+package dedup
+
+import (
+	"application/vacancy/dedup"
+	"context"
+	"domain/vacancy/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// description is a vacancy description reposted on several boards.
+const description = `We are looking for a Senior Go Developer to join our payments team. You will design, build and
+operate services handling millions of transactions a day, work with Kafka and PostgreSQL, and mentor other engineers.`
+
+// assign assigns the vacancy to a cluster and stores it.
+func assign(t *testing.T, service *dedup.Service, repo *MockVacancyRepository, v *entity.Vacancy) *entity.Vacancy {
+	ctx := context.Background()
+	require.NoError(t, service.Assign(ctx, v), "Assign should not fail")
+	require.NoError(t, repo.Save(ctx, v))
+	return v
+}
+
+// TestService_Assign verifies that reposts on other boards join the cluster of the canonical vacancy.
+func TestService_Assign(t *testing.T) {
+	var (
+		repo    = NewMockVacancyRepository()
+		service = dedup.NewService(repo, 6, 20)
+		krakow  = []entity.Place{{City: "Kraków", Country: "PL"}}
+	)
+
+	canonical := assign(t, service, repo, &entity.Vacancy{
+		Title: "Senior Go Developer", Company: "Acme", CompanyID: "c1", Location: "Kraków", Places: krakow,
+		Description: description, SourceName: "alfa",
+	})
+	repost := assign(t, service, repo, &entity.Vacancy{
+		Title: "Senior Go Developer (m/w/d)", Company: "ACME Sp. z o.o.", CompanyID: "c1",
+		Location: "Krakow, Poland", Places: []entity.Place{{City: "Kraków", Country: "PL", Remote: true}},
+		Description: description + " Apply now!", SourceName: "beta",
+	})
+	other := assign(t, service, repo, &entity.Vacancy{
+		Title: "Senior Go Developer", Company: "Acme", CompanyID: "c1", Location: "Kraków", Places: krakow,
+		Description: "Join our data team as a Python engineer building analytics pipelines with Spark and Airflow.",
+		SourceName:  "beta",
+	})
+
+	assert.False(t, canonical.ID.IsZero(), "Vacancy should be given an ID")
+	assert.True(t, canonical.Canonical(), "First vacancy should be the canonical record of its cluster")
+	assert.Equal(t, canonical.ClusterID, repost.ClusterID, "Repost should join the cluster of the canonical vacancy")
+	assert.False(t, repost.Canonical())
+	assert.Equal(t, canonical.Fingerprint, other.Fingerprint)
+	assert.True(t, other.Canonical(), "Different description should start a cluster of its own")
+}
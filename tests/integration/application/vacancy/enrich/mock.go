@@ -0,0 +1,27 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"time"
+)
+
+// MockParser returns a vacancy titled "Go Developer" with the page content as description.
+type MockParser struct{}
+
+// Parse is a mock implementation of the Parse.
+func (p *MockParser) Parse(html string) (*dto.Vacancy, error) {
+	return p.ParseAt(html, time.Now())
+}
+
+// ParseAt is a mock implementation of the ParseAt.
+func (p *MockParser) ParseAt(html string, fetchedAt time.Time) (*dto.Vacancy, error) {
+	v := dto.GetVacancy()
+	v.Title, v.Description = "Go Developer", html
+	return v, nil
+}
+
+// Name is a mock implementation of the Name.
+func (p *MockParser) Name() string { return "mock" }
+
+// Version is a mock implementation of the Version.
+func (p *MockParser) Version() string { return "2" }
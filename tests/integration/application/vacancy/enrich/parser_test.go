@@ -0,0 +1,69 @@
+package enrich
+
+import (
+	"application/url/processor/dto"
+	"application/vacancy/enrich"
+	"domain/vacancy/entity"
+	"infrastructure/salary"
+	"infrastructure/skills"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParser_Salary verifies that the salary enricher runs on every parsed vacancy.
+func TestParser_Salary(t *testing.T) {
+	normalizer := salary.NewNormalizer("EUR", map[string]float64{"PLN": 0.25})
+	parser := enrich.NewParser(&MockParser{}, enrich.NewSalary(normalizer))
+
+	assert.Equal(t, "mock", parser.Name(), "Parser name should be kept")
+	assert.Equal(t, "2+salary.2", parser.Version(), "Enricher versions should be part of the parser version")
+
+	v, err := parser.Parse("Offer: 10 000 - 12 000 PLN net (B2B)")
+	require.NoError(t, err)
+	require.NotNil(t, v.Salary, "Salary should be extracted from the description")
+	assert.Equal(t, "10000-12000 PLN/month net b2b", v.Salary.String())
+	assert.Equal(t, 36000.0, v.Salary.AnnualMax)
+
+	v, err = parser.Parse("No compensation announced.")
+	require.NoError(t, err)
+	assert.Nil(t, v.Salary, "No salary should be set")
+}
+
+// TestParser_SalaryFromParser verifies that a salary found by the parser is kept and normalised.
+func TestParser_SalaryFromParser(t *testing.T) {
+	v := dto.GetVacancy()
+	v.Description = "Up to 9 000 PLN"
+	v.Salary = &entity.Salary{Min: 100000, Max: 100000, Currency: "EUR", Period: entity.SalaryPeriodYear}
+
+	enrich.NewSalary(salary.NewNormalizer("EUR", nil)).Enrich(v)
+	assert.Equal(t, 100000.0, v.Salary.Min, "Structured salary should win over the text")
+	assert.Equal(t, 100000.0, v.Salary.AnnualMin)
+}
+
+// TestParser_Skills verifies that skills are tagged from the title and description.
+func TestParser_Skills(t *testing.T) {
+	taxonomy, err := skills.Load("")
+	require.NoError(t, err)
+	parser := enrich.NewParser(&MockParser{}, enrich.NewSkills(skills.NewExtractor(taxonomy)))
+
+	assert.Equal(t, "2+skills.1."+taxonomy.Version, parser.Version())
+
+	v, err := parser.Parse("Build services with k8s and Kafka.")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "kafka", "kubernetes"}, v.Skills)
+}
+
+// TestClassifier_Enrich verifies that values taken from structured data are kept and the rest derived from text.
+func TestClassifier_Enrich(t *testing.T) {
+	v := dto.GetVacancy()
+	v.Title, v.Location = "Senior Go Developer", "Remote"
+	v.EmploymentType = entity.EmploymentPartTime
+	v.Salary = &entity.Salary{Contract: entity.SalaryContractB2B}
+
+	enrich.NewClassifier().Enrich(v)
+	assert.Equal(t, entity.SenioritySenior, v.Seniority)
+	assert.Equal(t, entity.EmploymentPartTime, v.EmploymentType, "Structured employment type should be kept")
+	assert.Equal(t, entity.WorkModeRemote, v.WorkMode)
+}
@@ -0,0 +1,54 @@
+package reparse
+
+import (
+	"application/company"
+	"application/dependency"
+	"application/vacancy/dedup"
+	"application/vacancy/reparse"
+	"application/vacancy/store"
+	snapshotRepo "domain/snapshot/repository"
+	infraCompany "infrastructure/company"
+	"infrastructure/snapshot"
+	"log"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	VacancyRepository dependency.LazyDependency[*MockVacancyRepository]
+	SnapshotStore     dependency.LazyDependency[snapshotRepo.SnapshotRepository]
+	CompanyService    dependency.LazyDependency[*company.Service]
+	ReparseService    dependency.LazyDependency[*reparse.Service]
+}
+
+// NewTestContainer initializes a new test container storing snapshots under dir.
+func NewTestContainer(dir string) *TestContainer {
+	c := &TestContainer{}
+
+	c.VacancyRepository = dependency.LazyDependency[*MockVacancyRepository]{
+		InitFunc: NewMockVacancyRepository,
+	}
+	c.SnapshotStore = dependency.LazyDependency[snapshotRepo.SnapshotRepository]{
+		InitFunc: func() snapshotRepo.SnapshotRepository {
+			return snapshot.NewRepository(dir)
+		},
+	}
+	c.CompanyService = dependency.LazyDependency[*company.Service]{
+		InitFunc: func() *company.Service {
+			normalizer, err := infraCompany.NewNormalizer()
+			if err != nil {
+				log.Fatalf("failed to initialize company normalizer: %v", err)
+			}
+			return company.NewService(NewMockCompanyRepository(), normalizer)
+		},
+	}
+	c.ReparseService = dependency.LazyDependency[*reparse.Service]{
+		InitFunc: func() *reparse.Service {
+			batchSize := 2
+			repository := c.VacancyRepository.Get()
+			vacancyStore := store.NewService(repository, dedup.NewService(repository, 6, 20))
+			return reparse.NewService(repository, c.SnapshotStore.Get(), vacancyStore, c.CompanyService.Get(), batchSize)
+		},
+	}
+
+	return c
+}
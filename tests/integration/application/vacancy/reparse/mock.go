@@ -0,0 +1,223 @@
+package reparse
+
+import (
+	"application/url/processor/dto"
+	"context"
+	companyEntity "domain/company/entity"
+	"domain/vacancy/entity"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockVacancyRepository is an in-memory implementation of the VacancyRepository interface for testing purposes.
+type MockVacancyRepository struct {
+	mu        sync.Mutex
+	vacancies map[primitive.ObjectID]entity.Vacancy // vacancies holds the stored vacancies by ID.
+}
+
+// NewMockVacancyRepository creates an empty MockVacancyRepository.
+func NewMockVacancyRepository() *MockVacancyRepository {
+	return &MockVacancyRepository{vacancies: make(map[primitive.ObjectID]entity.Vacancy)}
+}
+
+// Save is a mock implementation of the Save.
+func (m *MockVacancyRepository) Save(ctx context.Context, vacancy *entity.Vacancy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if vacancy.ID.IsZero() {
+		vacancy.ID = primitive.NewObjectID()
+	}
+	m.vacancies[vacancy.ID] = *vacancy
+	return nil
+}
+
+// Update is a mock implementation of the Update.
+func (m *MockVacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy) error {
+	return m.Save(ctx, vacancy)
+}
+
+// Fetch is a mock implementation of the Fetch.
+func (m *MockVacancyRepository) Fetch(
+	ctx context.Context,
+	filters map[string]interface{},
+	limit, offset int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchBatch is a mock implementation of the FetchBatch.
+func (m *MockVacancyRepository) FetchBatch(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchOutdated is a mock implementation of the FetchOutdated.
+func (m *MockVacancyRepository) FetchOutdated(
+	ctx context.Context,
+	parser, version string,
+	hosts []string,
+	afterID string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var list []*entity.Vacancy
+	for _, v := range m.vacancies {
+		if !producedBy(&v, parser, hosts) || (version != "" && v.ParserVersion == version) || v.ID.Hex() <= afterID {
+			continue
+		}
+		list = append(list, &v)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID.Hex() < list[j].ID.Hex() })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// FetchByFingerprint is a mock implementation of the FetchByFingerprint.
+func (m *MockVacancyRepository) FetchByFingerprint(
+	ctx context.Context,
+	fingerprint string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchClosed is a mock implementation of the FetchClosed.
+func (m *MockVacancyRepository) FetchClosed(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// ClusterSent is a mock implementation of the ClusterSent.
+func (m *MockVacancyRepository) ClusterSent(ctx context.Context, clusterID string) (bool, error) {
+	return false, nil
+}
+
+// ReleaseCluster is a mock implementation of the ReleaseCluster.
+func (m *MockVacancyRepository) ReleaseCluster(ctx context.Context, clusterID string) (int, error) {
+	return 0, nil
+}
+
+// FindBySource is a mock implementation of the FindBySource.
+func (m *MockVacancyRepository) FindBySource(
+	ctx context.Context,
+	source, externalID, sourceURL string,
+) (*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FindByID is a mock implementation of the FindByID.
+func (m *MockVacancyRepository) FindByID(ctx context.Context, id string) (*entity.Vacancy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, v := range m.vacancies {
+		if v.ID.Hex() == id {
+			return &v, nil
+		}
+	}
+	return nil, fmt.Errorf("no document found with the id %s", id)
+}
+
+// MockCompanyRepository is an in-memory implementation of the CompanyRepository interface for testing purposes.
+type MockCompanyRepository struct {
+	mu        sync.Mutex
+	companies map[string]*companyEntity.Company // companies holds the stored companies by key.
+}
+
+// NewMockCompanyRepository creates an empty MockCompanyRepository.
+func NewMockCompanyRepository() *MockCompanyRepository {
+	return &MockCompanyRepository{companies: make(map[string]*companyEntity.Company)}
+}
+
+// Upsert is a mock implementation of the Upsert.
+func (m *MockCompanyRepository) Upsert(
+	ctx context.Context,
+	key, name string,
+	seenAt time.Time,
+) (*companyEntity.Company, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	company, ok := m.companies[key]
+	if !ok {
+		company = &companyEntity.Company{ID: primitive.NewObjectID(), Key: key, Name: name, FirstSeenAt: seenAt}
+		m.companies[key] = company
+	}
+	copied := *company
+	return &copied, nil
+}
+
+// IncrementVacancies is a mock implementation of the IncrementVacancies.
+func (m *MockCompanyRepository) IncrementVacancies(ctx context.Context, key string) error {
+	return nil
+}
+
+// SetStatus is a mock implementation of the SetStatus.
+func (m *MockCompanyRepository) SetStatus(
+	ctx context.Context,
+	key, name string,
+	status companyEntity.Status,
+) (*companyEntity.Company, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// FetchByKey is a mock implementation of the FetchByKey.
+func (m *MockCompanyRepository) FetchByKey(ctx context.Context, key string) (*companyEntity.Company, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if company, ok := m.companies[key]; ok {
+		copied := *company
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// MockParser extracts the title from the body, which holds "title|company|location".
+type MockParser struct {
+	version string // version is the version reported by the parser.
+}
+
+// Parse is a mock implementation of the Parse.
+func (p *MockParser) Parse(html string) (*dto.Vacancy, error) {
+	return p.ParseAt(html, time.Now())
+}
+
+// ParseAt is a mock implementation of the ParseAt, the layout carries no posting date.
+func (p *MockParser) ParseAt(html string, fetchedAt time.Time) (*dto.Vacancy, error) {
+	parts := strings.Split(html, "|")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected layout")
+	}
+	v := dto.GetVacancy()
+	v.Title, v.Company, v.Location = parts[0], parts[1], parts[2]
+	v.Description = strings.Repeat("Develop and maintain software. ", 3)
+	return v, nil
+}
+
+// Name is a mock implementation of the Name.
+func (p *MockParser) Name() string { return "alfa" }
+
+// Version is a mock implementation of the Version.
+func (p *MockParser) Version() string { return p.version }
+
+// producedBy reports whether the vacancy was produced by the parser, matching vacancies without a parser by
+// their source name or the host of their source URL.
+func producedBy(v *entity.Vacancy, parser string, hosts []string) bool {
+	if v.Parser != "" {
+		return v.Parser == parser
+	}
+	if v.SourceName == parser {
+		return true
+	}
+	u, err := url.Parse(v.SourceURL)
+	return err == nil && slices.Contains(hosts, u.Host)
+}
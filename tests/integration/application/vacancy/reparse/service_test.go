@@ -0,0 +1,151 @@
+package reparse
+
+import (
+	"application/vacancy/dedup"
+	"application/vacancy/reparse"
+	"context"
+	"domain/snapshot/entity"
+	vacancyEntity "domain/vacancy/entity"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seed stores a snapshot with the given body and a vacancy produced from it by the given parser version.
+func seed(t *testing.T, c *TestContainer, body, title, version string) *vacancyEntity.Vacancy {
+	ctx := context.Background()
+	snapshot := &entity.Snapshot{URL: "https://example.com/" + title, Body: body, FetchedAt: time.Now()}
+	require.NoError(t, c.SnapshotStore.Get().Save(ctx, snapshot), "Failed to save snapshot")
+
+	vacancy := &vacancyEntity.Vacancy{
+		Title:         title,
+		Company:       "TechCorp",
+		Description:   strings.Repeat("Develop and maintain software. ", 3),
+		Location:      "Berlin",
+		PostedAt:      time.Now(),
+		SentAt:        time.Now(),
+		SnapshotID:    snapshot.ID,
+		Parser:        "alfa",
+		ParserVersion: version,
+	}
+	require.NoError(t, c.VacancyRepository.Get().Save(ctx, vacancy), "Failed to save vacancy")
+	return vacancy
+}
+
+// TestService_Run_UpdatesOutdated verifies that outdated vacancies are re-parsed, changed ones are flagged
+// for re-send and vacancies produced by the current version are left alone.
+func TestService_Run_UpdatesOutdated(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+	ctx := context.Background()
+
+	changed := seed(t, container, "Go Developer|TechCorp|Berlin", "Unknown Title", "1")
+	unchanged := seed(t, container, "Rust Developer|TechCorp|Berlin", "Rust Developer", "1")
+	current := seed(t, container, "Java Developer|TechCorp|Berlin", "Old Title", "2")
+	broken := seed(t, container, "new layout", "Python Developer", "1")
+
+	result, err := container.ReparseService.Get().Run(ctx, &MockParser{version: "2"}, reparse.Options{})
+	require.NoError(t, err, "Re-parse should not fail")
+	assert.Equal(t, reparse.Result{Checked: 3, Changed: 1, Unchanged: 1, Failed: 1}, result)
+
+	stored, err := repo.FindByID(ctx, changed.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "Go Developer", stored.Title, "Changed field should be updated")
+	assert.Equal(t, "2", stored.ParserVersion, "Parser version should be bumped")
+	assert.True(t, stored.SentAt.IsZero(), "Changed vacancy should be flagged for re-send")
+
+	stored, err = repo.FindByID(ctx, unchanged.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "2", stored.ParserVersion, "Parser version should be bumped")
+	assert.False(t, stored.SentAt.IsZero(), "Unchanged vacancy should not be re-sent")
+	assert.Equal(t, "https://example.com/Rust Developer", stored.SourceURL, "Source URL should be backfilled")
+	assert.Equal(t, "alfa", stored.SourceName, "Source name should be backfilled")
+
+	stored, err = repo.FindByID(ctx, current.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "Old Title", stored.Title, "Current version should not be re-parsed")
+
+	stored, err = repo.FindByID(ctx, broken.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "1", stored.ParserVersion, "Failed re-parse should keep the old version")
+}
+
+// TestService_Run_DryRun verifies that a dry run reports differences without updating anything.
+func TestService_Run_DryRun(t *testing.T) {
+	container := SetupTestContainer(t)
+	ctx := context.Background()
+
+	vacancy := seed(t, container, "Go Developer|TechCorp|Berlin", "Unknown Title", "1")
+
+	result, err := container.ReparseService.Get().Run(ctx, &MockParser{version: "2"}, reparse.Options{DryRun: true})
+	require.NoError(t, err, "Re-parse should not fail")
+	assert.Equal(t, 1, result.Changed)
+
+	stored, err := container.VacancyRepository.Get().FindByID(ctx, vacancy.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown Title", stored.Title, "Dry run should not update vacancies")
+}
+
+// TestService_Run_Legacy verifies that vacancies stored without a parser are matched by the host of their source
+// URL, and are fingerprinted and linked to a cluster when they are re-parsed.
+func TestService_Run_Legacy(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+	ctx := context.Background()
+
+	legacy := seed(t, container, "Go Developer|TechCorp|Berlin", "Go Developer", "")
+	legacy.Parser, legacy.SourceURL = "", "https://example.com/jobs/1"
+	require.NoError(t, repo.Update(ctx, legacy))
+	foreign := seed(t, container, "Go Developer|TechCorp|Berlin", "Rust Developer", "")
+	foreign.Parser, foreign.SourceURL = "", "https://other.example/jobs/1"
+	require.NoError(t, repo.Update(ctx, foreign))
+
+	opts := reparse.Options{Hosts: []string{"example.com"}}
+	result, err := container.ReparseService.Get().Run(ctx, &MockParser{version: "2"}, opts)
+	require.NoError(t, err, "Re-parse should not fail")
+	assert.Equal(t, 1, result.Checked, "Only the vacancy served from the host of the source should be re-parsed")
+
+	stored, err := repo.FindByID(ctx, legacy.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "alfa", stored.Parser, "Parser should be recorded")
+	assert.NotEmpty(t, stored.Fingerprint, "Re-parsed vacancy should be fingerprinted")
+	assert.Equal(t, stored.ID.Hex(), stored.ClusterID, "Re-parsed vacancy should be linked to a cluster")
+	assert.Equal(t, stored.Hash(), stored.ContentHash)
+}
+
+// TestService_Run_CompanyChanged verifies that a vacancy whose company name changed between snapshots is linked
+// to the new company and fingerprinted with it, instead of keeping the company of the old name.
+func TestService_Run_CompanyChanged(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+	companies := container.CompanyService.Get()
+	ctx := context.Background()
+
+	old, err := companies.Resolve(ctx, "TechCorp", time.Now())
+	require.NoError(t, err)
+	vacancy := seed(t, container, "Go Developer|Globex|Berlin", "Go Developer", "1")
+	vacancy.CompanyID = old.ID.Hex()
+	require.NoError(t, repo.Update(ctx, vacancy))
+
+	result, err := container.ReparseService.Get().Run(ctx, &MockParser{version: "2"}, reparse.Options{})
+	require.NoError(t, err, "Re-parse should not fail")
+	assert.Equal(t, 1, result.Changed)
+
+	globex, err := companies.Resolve(ctx, "Globex", time.Now())
+	require.NoError(t, err)
+	stored, err := repo.FindByID(ctx, vacancy.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "Globex", stored.Company)
+	assert.Equal(t, globex.ID.Hex(), stored.CompanyID, "Vacancy should be linked to the re-parsed company")
+
+	expected, stale := *stored, *stored
+	stale.CompanyID = old.ID.Hex()
+	fingerprints := dedup.NewService(repo, 6, 20)
+	fingerprints.Fingerprint(&expected)
+	fingerprints.Fingerprint(&stale)
+	assert.Equal(t, expected.Fingerprint, stored.Fingerprint, "Fingerprint should follow the re-parsed company")
+	assert.NotEqual(t, stale.Fingerprint, stored.Fingerprint)
+}
@@ -0,0 +1,8 @@
+package reparse
+
+import "testing"
+
+// SetupTestContainer initializes the TestContainer with a temporary snapshot directory.
+func SetupTestContainer(t *testing.T) *TestContainer {
+	return NewTestContainer(t.TempDir())
+}
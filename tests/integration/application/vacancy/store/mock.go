@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"domain/vacancy/entity"
+	"sync"
+)
+
+// MockVacancyRepository is an in-memory implementation of the VacancyRepository interface for testing purposes.
+type MockVacancyRepository struct {
+	mu        sync.Mutex
+	vacancies []*entity.Vacancy // vacancies holds the stored vacancies in insertion order.
+	saves     int               // saves counts the calls of Save.
+	updates   int               // updates counts the calls of Update.
+}
+
+// NewMockVacancyRepository creates an empty MockVacancyRepository.
+func NewMockVacancyRepository() *MockVacancyRepository {
+	return &MockVacancyRepository{}
+}
+
+// Save is a mock implementation of the Save.
+func (m *MockVacancyRepository) Save(ctx context.Context, vacancy *entity.Vacancy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saves++
+	copied := *vacancy
+	m.vacancies = append(m.vacancies, &copied)
+	return nil
+}
+
+// Update is a mock implementation of the Update.
+func (m *MockVacancyRepository) Update(ctx context.Context, vacancy *entity.Vacancy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updates++
+	for i, stored := range m.vacancies {
+		if stored.ID == vacancy.ID {
+			copied := *vacancy
+			m.vacancies[i] = &copied
+		}
+	}
+	return nil
+}
+
+// Fetch is a mock implementation of the Fetch.
+func (m *MockVacancyRepository) Fetch(
+	ctx context.Context,
+	filters map[string]interface{},
+	limit, offset int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchBatch is a mock implementation of the FetchBatch.
+func (m *MockVacancyRepository) FetchBatch(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchOutdated is a mock implementation of the FetchOutdated.
+func (m *MockVacancyRepository) FetchOutdated(
+	ctx context.Context,
+	parser, version string,
+	hosts []string,
+	afterID string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// FetchByFingerprint is a mock implementation of the FetchByFingerprint.
+func (m *MockVacancyRepository) FetchByFingerprint(
+	ctx context.Context,
+	fingerprint string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var list []*entity.Vacancy
+	for i := len(m.vacancies) - 1; i >= 0 && len(list) < limit; i-- {
+		if m.vacancies[i].Fingerprint == fingerprint && !m.vacancies[i].Quarantined {
+			list = append(list, m.vacancies[i])
+		}
+	}
+	return list, nil
+}
+
+// Calls returns the number of calls of Save and Update.
+func (m *MockVacancyRepository) Calls() (saves, updates int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saves, m.updates
+}
+
+// FetchClosed is a mock implementation of the FetchClosed.
+func (m *MockVacancyRepository) FetchClosed(ctx context.Context, limit int) ([]*entity.Vacancy, error) {
+	return nil, nil
+}
+
+// ClusterSent is a mock implementation of the ClusterSent.
+func (m *MockVacancyRepository) ClusterSent(ctx context.Context, clusterID string) (bool, error) {
+	return false, nil
+}
+
+// ReleaseCluster is a mock implementation of the ReleaseCluster.
+func (m *MockVacancyRepository) ReleaseCluster(ctx context.Context, clusterID string) (int, error) {
+	return 0, nil
+}
+
+// FindBySource is a mock implementation of the FindBySource.
+func (m *MockVacancyRepository) FindBySource(
+	ctx context.Context,
+	source, externalID, sourceURL string,
+) (*entity.Vacancy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, stored := range m.vacancies {
+		if stored.SourceName != source {
+			continue
+		}
+		if (externalID != "" && stored.ExternalID == externalID) || (externalID == "" && stored.SourceURL == sourceURL) {
+			copied := *stored
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByID is a mock implementation of the FindByID.
+func (m *MockVacancyRepository) FindByID(ctx context.Context, id string) (*entity.Vacancy, error) {
+	return nil, nil
+}
@@ -0,0 +1,145 @@
+package store
+
+import (
+	"application/vacancy/dedup"
+	"application/vacancy/store"
+	"context"
+	"domain/vacancy/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// crawl returns the vacancy as parsed from its page on a crawl.
+func crawl(description string) *entity.Vacancy {
+	return &entity.Vacancy{
+		Title: "Go Developer", Company: "Acme", Description: description, Location: "Kyiv",
+		SourceName: "alfa", SourceURL: "https://alfa.example/jobs/1234", ExternalID: "1234",
+	}
+}
+
+// TestService_Save verifies that re-crawled vacancies are updated in place only when their content changed.
+func TestService_Save(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		repo    = NewMockVacancyRepository()
+		service = store.NewService(repo, dedup.NewService(repo, 6, 20))
+	)
+
+	first := crawl("Build payment services in Go.")
+	outcome, err := service.Save(ctx, first)
+	require.NoError(t, err, "Save should not fail")
+	assert.Equal(t, store.OutcomeCreated, outcome)
+	assert.NotEmpty(t, first.ContentHash)
+	assert.NotEmpty(t, first.ClusterID, "New vacancy should be assigned a cluster")
+
+	// Mark the vacancy as sent, as the transfer job does.
+	first.SentAt, first.RemoteID = time.Now(), 42
+	require.NoError(t, repo.Update(ctx, first))
+
+	again := crawl("Build payment services in Go.")
+	again.PostedAt = time.Now()
+	again.SnapshotID, again.Parser, again.ParserVersion = "snapshot-2", "alfa", "4"
+	outcome, err = service.Save(ctx, again)
+	require.NoError(t, err)
+	assert.Equal(t, store.OutcomeUnchanged, outcome, "Same content should leave the vacancy alone")
+	assert.Equal(t, first.ID, again.ID)
+
+	stored, err := repo.FindBySource(ctx, "alfa", "1234", "")
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot-2", stored.SnapshotID, "Unchanged vacancy should reference the latest snapshot")
+	assert.Equal(t, "4", stored.ParserVersion, "Unchanged vacancy should record the parser version")
+	assert.True(t, stored.UpdatedAt.After(first.UpdatedAt), "Unchanged vacancy should record the crawl")
+	assert.False(t, stored.SentAt.IsZero(), "Unchanged vacancy should not be sent again")
+
+	changed := crawl("Build and operate payment services in Go.")
+	outcome, err = service.Save(ctx, changed)
+	require.NoError(t, err)
+	assert.Equal(t, store.OutcomeUpdated, outcome)
+	assert.Equal(t, first.ID, changed.ID, "Changed vacancy should keep its identity")
+	assert.Equal(t, first.ClusterID, changed.ClusterID, "Changed vacancy should keep its cluster")
+	assert.Equal(t, int64(42), changed.RemoteID, "Changed vacancy should keep its remote ID")
+	assert.True(t, changed.SentAt.IsZero(), "Changed vacancy should be flagged to be sent again")
+	assert.False(t, changed.UpdatedAt.IsZero())
+
+	saves, updates := repo.Calls()
+	assert.Equal(t, 1, saves, "Re-crawls should not insert new vacancies")
+	assert.Equal(t, 3, updates)
+}
+
+// TestService_SaveQuarantined verifies that a quarantined re-crawl never replaces a valid stored vacancy,
+// while it does replace a quarantined one.
+func TestService_SaveQuarantined(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		repo    = NewMockVacancyRepository()
+		service = store.NewService(repo, dedup.NewService(repo, 6, 20))
+	)
+
+	valid := crawl("Build payment services in Go.")
+	_, err := service.Save(ctx, valid)
+	require.NoError(t, err)
+
+	broken := crawl("-")
+	broken.Quarantine("description is a placeholder")
+	outcome, err := service.Save(ctx, broken)
+	require.NoError(t, err, "Save should not fail")
+	assert.Equal(t, store.OutcomeKept, outcome, "Valid vacancy should be kept")
+	assert.Equal(t, valid.ID, broken.ID)
+
+	stored, err := repo.FindBySource(ctx, "alfa", "1234", "")
+	require.NoError(t, err)
+	assert.False(t, stored.Quarantined, "Stored vacancy should stay valid")
+	assert.Equal(t, valid.Description, stored.Description)
+
+	// A quarantined vacancy is replaced by its next extraction, whether valid or not.
+	other := crawl("-")
+	other.SourceURL, other.ExternalID = "https://alfa.example/jobs/5678", "5678"
+	other.Quarantine("description is a placeholder")
+	_, err = service.Save(ctx, other)
+	require.NoError(t, err)
+
+	fixed := crawl("Build reporting services in Go.")
+	fixed.SourceURL, fixed.ExternalID = other.SourceURL, other.ExternalID
+	outcome, err = service.Save(ctx, fixed)
+	require.NoError(t, err)
+	assert.Equal(t, store.OutcomeUpdated, outcome, "Quarantined vacancy should be replaced")
+	saves, _ := repo.Calls()
+	assert.Equal(t, 2, saves, "Replacing a quarantined vacancy should not insert a new one")
+}
+
+// TestService_Close verifies that closed vacancies are recorded once and reopen when they are crawled again.
+func TestService_Close(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		repo    = NewMockVacancyRepository()
+		service = store.NewService(repo, dedup.NewService(repo, 6, 20))
+		first   = time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+	)
+
+	vacancy := crawl("Build payment services in Go.")
+	_, err := service.Save(ctx, vacancy)
+	require.NoError(t, err)
+
+	closed, err := service.Close(ctx, "alfa", "https://alfa.example/jobs/9999", first)
+	require.NoError(t, err)
+	assert.False(t, closed, "Unknown URL should not close any vacancy")
+
+	closed, err = service.Close(ctx, "alfa", vacancy.SourceURL, first)
+	require.NoError(t, err, "Close should not fail")
+	assert.True(t, closed)
+	_, err = service.Close(ctx, "alfa", vacancy.SourceURL, first.Add(time.Hour))
+	require.NoError(t, err)
+
+	stored, err := repo.FindBySource(ctx, "alfa", "", vacancy.SourceURL)
+	require.NoError(t, err)
+	assert.Equal(t, first, stored.ClosedAt, "Vacancy should keep the time it was first found closed")
+
+	reopened := crawl("Build payment services in Go.")
+	outcome, err := service.Save(ctx, reopened)
+	require.NoError(t, err)
+	assert.Equal(t, store.OutcomeUpdated, outcome, "Closed vacancy found open again should be updated")
+	assert.False(t, reopened.Closed())
+}
@@ -0,0 +1,30 @@
+package vacancy
+
+import (
+	"domain/vacancy/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVacancy_SetPostedAt verifies that a posting date is only replaced by one of equal or higher confidence.
+func TestVacancy_SetPostedAt(t *testing.T) {
+	low := time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC)
+	high := time.Date(2024, time.March, 11, 8, 15, 0, 0, time.UTC)
+	v := &entity.Vacancy{}
+
+	v.SetPostedAt(low, entity.DateConfidenceLow)
+	assert.Equal(t, low, v.PostedAt)
+	assert.Equal(t, entity.DateConfidenceLow, v.PostedAtConfidence)
+
+	v.SetPostedAt(high, entity.DateConfidenceHigh)
+	assert.Equal(t, high, v.PostedAt, "Higher confidence should replace the date")
+	assert.Equal(t, entity.DateConfidenceHigh, v.PostedAtConfidence)
+
+	v.SetPostedAt(low, entity.DateConfidenceMedium)
+	assert.Equal(t, high, v.PostedAt, "Lower confidence should not replace the date")
+
+	v.SetPostedAt(time.Time{}, entity.DateConfidenceHigh)
+	assert.Equal(t, high, v.PostedAt, "Zero dates should be ignored")
+}
@@ -0,0 +1,30 @@
+package vacancy
+
+import (
+	"domain/vacancy/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVacancy_Hash verifies that only the published content of a vacancy changes its hash.
+func TestVacancy_Hash(t *testing.T) {
+	v := &entity.Vacancy{Title: "Go Developer", Company: "Acme", Description: "Build services.", Location: "Kyiv"}
+	hash := v.Hash()
+	assert.Len(t, hash, 64, "Hash should be a hex encoded SHA-256")
+
+	refreshed := *v
+	refreshed.PostedAt = time.Now()
+	refreshed.Skills = []string{"go"}
+	refreshed.Language = "en"
+	assert.Equal(t, hash, refreshed.Hash(), "Posting date and derived fields should not change the hash")
+
+	edited := *v
+	edited.Description = "Build and operate services."
+	assert.NotEqual(t, hash, edited.Hash(), "Changed description should change the hash")
+
+	shifted := *v
+	shifted.Title, shifted.Company = "Go Developer Acme", ""
+	assert.NotEqual(t, hash, shifted.Hash(), "Text moved between fields should change the hash")
+}
@@ -0,0 +1,74 @@
+package vacancy
+
+import (
+	"domain/vacancy/entity"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validVacancy returns a vacancy passing every validation rule.
+func validVacancy() *entity.Vacancy {
+	return &entity.Vacancy{
+		Title:       "Senior Go Developer",
+		Company:     "TechCorp",
+		Description: strings.Repeat("Develop and maintain software. ", 3),
+		PostedAt:    time.Now(),
+		Location:    "Berlin",
+	}
+}
+
+// TestVacancy_Validate_Valid verifies that a complete vacancy passes validation.
+func TestVacancy_Validate_Valid(t *testing.T) {
+	assert.NoError(t, validVacancy().Validate(), "Complete vacancy should be valid")
+}
+
+// TestVacancy_Validate_OptionalLocation verifies that a vacancy without a location is valid.
+func TestVacancy_Validate_OptionalLocation(t *testing.T) {
+	for _, location := range []string{"", "-", "Unknown Location"} {
+		v := validVacancy()
+		v.Location = location
+		assert.NoError(t, v.Validate(), "Vacancy with location %q should be valid", location)
+	}
+}
+
+// TestVacancy_Validate_Invalid verifies that missing fields, placeholders and short descriptions are rejected.
+func TestVacancy_Validate_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(v *entity.Vacancy)
+		problem string
+	}{
+		{name: "missing title", modify: func(v *entity.Vacancy) { v.Title = " " }, problem: "title is missing"},
+		{name: "placeholder title", modify: func(v *entity.Vacancy) { v.Title = "Unknown Title" }, problem: "title is a placeholder"},
+		{name: "placeholder company", modify: func(v *entity.Vacancy) { v.Company = "unknown company" }, problem: "company is a placeholder"},
+		{name: "dash description", modify: func(v *entity.Vacancy) { v.Description = "-" }, problem: "description is a placeholder"},
+		{name: "short description", modify: func(v *entity.Vacancy) { v.Description = "Write Go." }, problem: "description is too short"},
+		{name: "missing posted date", modify: func(v *entity.Vacancy) { v.PostedAt = time.Time{} }, problem: "posted date is missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validVacancy()
+			tt.modify(v)
+
+			err := v.Validate()
+			var vErr *entity.ValidationError
+			require.True(t, errors.As(err, &vErr), "Validation should fail with a ValidationError")
+			require.Len(t, vErr.Problems, 1, "Exactly one problem should be reported")
+			assert.Contains(t, vErr.Problems[0], tt.problem)
+		})
+	}
+}
+
+// TestVacancy_Quarantine verifies that quarantining records the reason.
+func TestVacancy_Quarantine(t *testing.T) {
+	v := validVacancy()
+	v.Quarantine("title is a placeholder")
+	assert.True(t, v.Quarantined)
+	assert.Equal(t, "title is a placeholder", v.QuarantineReason)
+}
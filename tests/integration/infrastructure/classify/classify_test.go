@@ -0,0 +1,54 @@
+package classify
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/classify"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSeniority verifies that the level is taken from the title first and from required experience second.
+func TestSeniority(t *testing.T) {
+	tests := []struct {
+		title       string
+		description string
+		expected    entity.Seniority
+	}{
+		{"Senior Go Developer", "", entity.SenioritySenior},
+		{"Junior/Mid Backend Engineer", "", entity.SeniorityJunior},
+		{"Tech Lead (Go)", "", entity.SeniorityLead},
+		{"Staff Software Engineer", "", entity.SeniorityPrincipal},
+		{"Werkstudent Softwareentwicklung", "", entity.SeniorityIntern},
+		{"International Sales Go Developer", "", ""},
+		{"Go Developer", "You have 5+ years of commercial experience with Go.", entity.SenioritySenior},
+		{"Programista Go", "Minimum 3 lata doświadczenia w Go.", entity.SeniorityMid},
+		{"Go Developer", "We have been on the market for 20 years.", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title+" "+tt.description, func(t *testing.T) {
+			assert.Equal(t, tt.expected, classify.Seniority(tt.title, tt.description))
+		})
+	}
+}
+
+// TestEmploymentType verifies that the salary contract wins over the first contract mentioned in the text.
+func TestEmploymentType(t *testing.T) {
+	b2b := &entity.Salary{Contract: entity.SalaryContractB2B}
+
+	assert.Equal(t, entity.EmploymentB2B, classify.EmploymentType(b2b, "Go Developer", "Employment contract"))
+	assert.Equal(t, entity.EmploymentPermanent, classify.EmploymentType(nil, "Go Developer", "Umowa o pracę lub B2B"))
+	assert.Equal(t, entity.EmploymentPartTime, classify.EmploymentType(nil, "Go Developer (part-time)", ""))
+	assert.Equal(t, entity.EmploymentContract, classify.EmploymentType(nil, "Freelance Go Developer", ""))
+	assert.Empty(t, classify.EmploymentType(&entity.Salary{}, "Go Developer", "Join us."))
+}
+
+// TestWorkMode verifies that the location is searched before the title and description.
+func TestWorkMode(t *testing.T) {
+	assert.Equal(t, entity.WorkModeRemote, classify.WorkMode("Remote (EU)", "", "Optional office in Berlin, on-site"))
+	assert.Equal(t, entity.WorkModeHybrid, classify.WorkMode("Kraków", "Go Developer", "Praca hybrydowa, 2 dni w biurze"))
+	assert.Equal(t, entity.WorkModeRemote, classify.WorkMode("-", "Go Developer", "100% zdalnie"))
+	assert.Equal(t, entity.WorkModeOnsite, classify.WorkMode("Berlin", "", "You work on-site in our office."))
+	assert.Empty(t, classify.WorkMode("Berlin", "Go Developer", "Build services."))
+}
@@ -0,0 +1,38 @@
+package company
+
+import (
+	"infrastructure/company"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalizer_Key verifies that legal suffixes, case, diacritics and known aliases are normalised away.
+func TestNormalizer_Key(t *testing.T) {
+	normalizer, err := company.NewNormalizer()
+	require.NoError(t, err, "Embedded alias table should be valid")
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"ACME Sp. z o.o.", "acme"},
+		{"Acme", "acme"},
+		{"ACME S.A.", "acme"},
+		{"acme sp. z o.o. sp. k.", "acme"},
+		{"Żabka Polska Sp. z o.o.", "zabka polska"},
+		{"Müller GmbH & Co. KG", "muller"},
+		{"Globex, Inc.", "globex"},
+		{"Ernst & Young Sp. z o.o.", "ey"},
+		{"Google Poland", "google"},
+		{"ТОВ Софтсерв", "софтсерв"},
+		{"SA", "sa"},
+		{"  ", ""},
+		{"-", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, normalizer.Key(tt.name), "Key of %q", tt.name)
+	}
+}
@@ -0,0 +1,50 @@
+package coverage
+
+import (
+	"application/config"
+	"application/dependency"
+	coverageRepo "domain/coverage/repository"
+	"fmt"
+	"infrastructure/coverage"
+	infraMongo "infrastructure/mongo"
+	"log"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	Config             dependency.LazyDependency[*config.Config]
+	MongoClient        dependency.LazyDependency[*mongo.Client]
+	CoverageRepository dependency.LazyDependency[coverageRepo.CoverageRepository]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.Config = dependency.LazyDependency[*config.Config]{
+		InitFunc: config.LoadConfig,
+	}
+	c.MongoClient = dependency.LazyDependency[*mongo.Client]{
+		InitFunc: func() *mongo.Client {
+			cfg := c.Config.Get()
+			uri := fmt.Sprintf("mongodb://%s:%s@%s:%s", cfg.Mongo.User, cfg.Mongo.Pass, cfg.Mongo.Host, cfg.Mongo.Port)
+			mongoClient, err := infraMongo.NewMongoClient(uri)
+			if err != nil {
+				log.Fatalf("mongo client error: %v", err)
+			}
+			return mongoClient
+		},
+	}
+	c.CoverageRepository = dependency.LazyDependency[coverageRepo.CoverageRepository]{
+		InitFunc: func() coverageRepo.CoverageRepository {
+			mongoClient := c.MongoClient.Get()
+			cfg := c.Config.Get()
+			collection := mongoClient.Database(cfg.Mongo.DB).Collection(cfg.Mongo.CoverageCollection)
+			return coverage.NewRepository(mongoClient, collection)
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,71 @@
+package coverage
+
+import (
+	"context"
+	"domain/coverage/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepository_FetchBaseline validates that only batches of the source that did not drift and were not sparse
+// are returned.
+func TestRepository_FetchBaseline(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.CoverageRepository.Get()
+
+	ctx := context.Background()
+	start := time.Now().Add(-time.Hour)
+	for i := range 3 {
+		coverage := &entity.Coverage{
+			Source:    "alfa",
+			CreatedAt: start.Add(time.Duration(i) * time.Minute),
+			Samples:   i + 1,
+			Fields:    map[string]float64{"title": 1},
+		}
+		require.NoError(t, repo.Save(ctx, coverage), "Failed to save coverage")
+		assert.False(t, coverage.ID.IsZero(), "ID should be assigned on save")
+	}
+	drifted := &entity.Coverage{Source: "alfa", CreatedAt: time.Now(), Drift: []string{"title"}}
+	sparse := &entity.Coverage{Source: "alfa", CreatedAt: time.Now(), Sparse: true}
+	other := &entity.Coverage{Source: "beta", CreatedAt: time.Now()}
+	for _, coverage := range []*entity.Coverage{drifted, sparse, other} {
+		require.NoError(t, repo.Save(ctx, coverage), "Failed to save coverage")
+	}
+
+	list, err := repo.FetchBaseline(ctx, "alfa", 2)
+	require.NoError(t, err, "Failed to fetch baseline")
+	require.Len(t, list, 2, "Unexpected number of results")
+	assert.Equal(t, 3, list[0].Samples, "Newest batch should come first")
+	assert.Equal(t, 2, list[1].Samples, "Batches should be sorted by creation time")
+	assert.Equal(t, map[string]float64{"title": 1}, list[0].Fields, "Fields are not as expected")
+}
+
+// TestRepository_FetchPause validates that only pauses lasting beyond now are returned.
+func TestRepository_FetchPause(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.CoverageRepository.Get()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	paused, err := repo.FetchPause(ctx, "alfa", now)
+	require.NoError(t, err, "Failed to fetch pause")
+	assert.Nil(t, paused, "Source should not be paused")
+
+	expired := &entity.Coverage{Source: "alfa", CreatedAt: now.Add(-2 * time.Hour), PausedUntil: now.Add(-time.Hour)}
+	active := &entity.Coverage{Source: "alfa", CreatedAt: now.Add(-time.Minute), PausedUntil: now.Add(time.Hour)}
+	require.NoError(t, repo.Save(ctx, expired), "Failed to save coverage")
+	require.NoError(t, repo.Save(ctx, active), "Failed to save coverage")
+
+	paused, err = repo.FetchPause(ctx, "alfa", now)
+	require.NoError(t, err, "Failed to fetch pause")
+	require.NotNil(t, paused, "Source should be paused")
+	assert.Equal(t, active.ID, paused.ID, "ID is not as expected")
+
+	paused, err = repo.FetchPause(ctx, "beta", now)
+	require.NoError(t, err, "Failed to fetch pause")
+	assert.Nil(t, paused, "Other sources should not be paused")
+}
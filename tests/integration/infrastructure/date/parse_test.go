@@ -0,0 +1,97 @@
+package date
+
+import (
+	"infrastructure/date"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ref is the time relative dates are resolved against.
+var ref = time.Date(2024, time.March, 15, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+// TestParseAbsolute verifies parsing of standard and localised absolute dates.
+func TestParseAbsolute(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-03-12T09:30:00Z", time.Date(2024, time.March, 12, 9, 30, 0, 0, time.UTC)},
+		{"2024-03-12T09:30:00+02:00", time.Date(2024, time.March, 12, 7, 30, 0, 0, time.UTC)},
+		{"2024-03-12", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"Tue, 12 Mar 2024 09:30:00 GMT", time.Date(2024, time.March, 12, 9, 30, 0, 0, time.UTC)},
+		{"Tue, 12 Mar 2024 09:30:00 +0000", time.Date(2024, time.March, 12, 9, 30, 0, 0, time.UTC)},
+		{"12.03.2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"12 March 2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"March 12th, 2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"12. März 2024, 14:30", time.Date(2024, time.March, 12, 14, 30, 0, 0, ref.Location())},
+		{"12 марта 2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"12 березня 2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"martes, 12 de marzo de 2024", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"12 lutego 2024", time.Date(2024, time.February, 12, 0, 0, 0, 0, ref.Location())},
+		{"12 mars", time.Date(2024, time.March, 12, 0, 0, 0, 0, ref.Location())},
+		{"20 December", time.Date(2023, time.December, 20, 0, 0, 0, 0, ref.Location())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			parsed, err := date.ParseAbsolute(tt.value, ref)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(parsed), "expected %s, got %s", tt.expected, parsed)
+		})
+	}
+}
+
+// TestParseAbsolute_Invalid verifies that values without a date are rejected.
+func TestParseAbsolute_Invalid(t *testing.T) {
+	for _, value := range []string{"", "Apply now", "31 February 2024", "2024"} {
+		_, err := date.ParseAbsolute(value, ref)
+		assert.ErrorIs(t, err, date.ErrUnknownFormat, "value %q should be rejected", value)
+	}
+}
+
+// TestParseRelative verifies that relative phrases are resolved against the reference time.
+func TestParseRelative(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"3 days ago", ref.AddDate(0, 0, -3)},
+		{"Posted an hour ago", ref.Add(-time.Hour)},
+		{"2d ago", ref.AddDate(0, 0, -2)},
+		{"yesterday", ref.AddDate(0, 0, -1)},
+		{"Today", ref},
+		{"vor 2 Wochen", ref.AddDate(0, 0, -14)},
+		{"vor einem Monat", ref.AddDate(0, -1, 0)},
+		{"il y a un an", ref.AddDate(-1, 0, 0)},
+		{"hace 5 días", ref.AddDate(0, 0, -5)},
+		{"2 giorni fa", ref.AddDate(0, 0, -2)},
+		{"3 дня назад", ref.AddDate(0, 0, -3)},
+		{"5 годин тому", ref.Add(-5 * time.Hour)},
+		{"вчера", ref.AddDate(0, 0, -1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			parsed, err := date.ParseRelative(tt.value, ref)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(parsed), "expected %s, got %s", tt.expected, parsed)
+		})
+	}
+}
+
+// TestParse verifies that relative phrases are reported as such.
+func TestParse(t *testing.T) {
+	_, relative, err := date.Parse("3 days ago", ref)
+	require.NoError(t, err)
+	assert.True(t, relative, "Relative phrase should be reported")
+
+	_, relative, err = date.Parse("12 March 2024", ref)
+	require.NoError(t, err)
+	assert.False(t, relative, "Absolute date should not be reported as relative")
+
+	_, _, err = date.Parse("3 days", ref)
+	assert.ErrorIs(t, err, date.ErrUnknownFormat, "Phrases not marked as past should be rejected")
+}
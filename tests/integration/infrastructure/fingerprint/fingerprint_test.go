@@ -0,0 +1,39 @@
+package fingerprint
+
+import (
+	"infrastructure/fingerprint"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// description is a vacancy description reposted on several boards.
+const description = `We are looking for a Senior Go Developer to join our payments team in Kraków. You will design,
+build and operate services handling millions of transactions a day, work with Kafka and PostgreSQL, and mentor
+other engineers. We offer a B2B contract, private health care and a flexible schedule.`
+
+// TestKey verifies that titles, companies and locations are normalised before being fingerprinted.
+func TestKey(t *testing.T) {
+	key := fingerprint.Key("Senior Go Developer (m/w/d)", "Acme", "Kraków")
+
+	assert.Equal(t, key, fingerprint.Key("senior go developer", "ACME", "Krakow"))
+	assert.Equal(t, key, fingerprint.Key("Senior Go Developer [Remote]", " acme ", "KRAKÓW"))
+	assert.NotEqual(t, key, fingerprint.Key("Senior Go Developer", "Acme", "Warsaw"))
+	assert.NotEqual(t, key, fingerprint.Key("Senior Go", "Developer Acme", "Kraków"), "Parts should not run together")
+}
+
+// TestSimHash verifies that reposts with small edits stay close while different descriptions do not.
+func TestSimHash(t *testing.T) {
+	hash := fingerprint.SimHash(description)
+
+	reposted := fingerprint.SimHash(strings.ToUpper(description) + " Apply now!")
+	assert.LessOrEqual(t, fingerprint.Distance(hash, reposted), 6, "Repost with a footer should be a near-duplicate")
+
+	other := fingerprint.SimHash(`Join our data team in Berlin as a Python engineer building analytics pipelines
+with Spark and Airflow. Permanent contract, relocation package and a yearly bonus.`)
+	assert.Greater(t, fingerprint.Distance(hash, other), 6, "Different description should not be a near-duplicate")
+
+	assert.Zero(t, fingerprint.SimHash(""))
+	assert.Zero(t, fingerprint.Distance(hash, hash))
+}
@@ -0,0 +1,81 @@
+package geo
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/geo"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newParser creates a location parser backed by the embedded gazetteer.
+func newParser(t *testing.T) *geo.Parser {
+	gazetteer, err := geo.NewGazetteer()
+	require.NoError(t, err, "Embedded gazetteer should be valid")
+	return geo.NewParser(gazetteer)
+}
+
+// format formats places for comparison.
+func format(places []entity.Place) []string {
+	formatted := make([]string, len(places))
+	for i, place := range places {
+		formatted[i] = place.String()
+	}
+	return formatted
+}
+
+// TestParser_Parse verifies splitting, city resolution and remote qualifiers.
+func TestParser_Parse(t *testing.T) {
+	parser := newParser(t)
+
+	tests := []struct {
+		raw      string
+		expected []string
+	}{
+		{"-", []string{}},
+		{"Unknown Location", []string{}},
+		{"Warszawa", []string{"Warsaw, PL"}},
+		{"Krakow, Poland", []string{"Kraków, PL"}},
+		{"Remote (EU)", []string{"Remote (EU)"}},
+		{"Berlin; München / Köln", []string{"Berlin, DE", "Munich, DE", "Cologne, DE"}},
+		{"Wrocław (Hybrid) or Remote", []string{"Wrocław, PL", "Remote"}},
+		{"Poland (Remote)", []string{"Remote, PL"}},
+		{"Remote from Ukraine", []string{"Remote, UA"}},
+		{"Warsaw, Masovia, PL", []string{"Warsaw, PL"}},
+		{"London, Ontario", []string{"London, CA"}},
+		{"London, England", []string{"London, GB"}},
+		{"Warsaw, USA", []string{"Warsaw, US"}},
+		{"Munich, Bayern, Germany", []string{"Munich, DE"}},
+		{"London, New York", []string{"London, GB", "New York, US"}},
+		{"Львів", []string{"Lviv, UA"}},
+		{"Germany", []string{"DE"}},
+		{"Neustadt an der Weinstraße", []string{"Neustadt an der Weinstraße"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			assert.Equal(t, tt.expected, format(parser.Parse(tt.raw)))
+		})
+	}
+}
+
+// TestParser_Parse_Point verifies that resolved cities carry GeoJSON coordinates.
+func TestParser_Parse_Point(t *testing.T) {
+	places := newParser(t).Parse("Gdańsk")
+	require.Len(t, places, 1)
+	require.NotNil(t, places[0].Point)
+
+	assert.Equal(t, "Point", places[0].Point.Type)
+	assert.InDelta(t, 18.65, places[0].Point.Coordinates[0], 0.01, "Longitude comes first")
+	assert.InDelta(t, 54.35, places[0].Point.Coordinates[1], 0.01)
+}
+
+// TestParser_Parse_Pinned verifies that a city whose qualifier names another country than the one of its
+// namesake in the gazetteer is left without coordinates.
+func TestParser_Parse_Pinned(t *testing.T) {
+	places := newParser(t).Parse("London, Ontario")
+	require.Len(t, places, 1)
+
+	assert.Equal(t, entity.Place{City: "London", Country: "CA"}, places[0])
+}
@@ -0,0 +1,28 @@
+package html
+
+import (
+	"application/dependency"
+	"domain/html"
+	htmlAlfa "infrastructure/html/source/alfa"
+	"net/http"
+	"time"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	HtmlFetcher dependency.LazyDependency[html.Fetcher]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.HtmlFetcher = dependency.LazyDependency[html.Fetcher]{
+		InitFunc: func() html.Fetcher {
+			maxBodySize := int64(1024 * 1024) // 1MB
+			return htmlAlfa.NewFetcher(&http.Client{Timeout: 5 * time.Second}, maxBodySize)
+		},
+	}
+
+	return c
+}
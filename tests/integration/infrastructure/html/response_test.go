@@ -0,0 +1,123 @@
+package html
+
+import (
+	"context"
+	"domain/pipeline"
+	"fmt"
+	infraHtml "infrastructure/html"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFetcher_ClassifiesFailures verifies that fetch failures are reported with distinct reasons.
+func TestFetcher_ClassifiesFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = fmt.Fprint(w, "<html><title>Go Developer</title></html>")
+		case "/banned":
+			w.WriteHeader(http.StatusForbidden)
+		case "/captcha":
+			_, _ = fmt.Fprint(w, "<html><title>Attention Required! | Cloudflare</title></html>")
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/jobs/42":
+			http.Redirect(w, r, "/jobs", http.StatusMovedPermanently)
+		case "/jobs/43":
+			_, _ = fmt.Fprint(w, "<html><p>Вакансія закрита роботодавцем</p></html>")
+		case "/jobs":
+			_, _ = fmt.Fprint(w, "<html><title>All jobs</title></html>")
+		}
+	}))
+	defer server.Close()
+
+	container := SetupTestContainer()
+	fetcher := container.HtmlFetcher.Get()
+	ctx := context.Background()
+
+	response, err := fetcher.Fetch(ctx, server.URL+"/ok")
+	require.NoError(t, err, "Regular page should be fetched")
+	assert.Contains(t, response.Body, "Go Developer")
+	assert.Equal(t, server.URL+"/ok", response.FinalURL, "Final URL should be recorded")
+
+	tests := []struct {
+		path   string
+		reason pipeline.Reason
+		status int
+	}{
+		{path: "/banned", reason: pipeline.ReasonBlocked, status: http.StatusForbidden},
+		{path: "/captcha", reason: pipeline.ReasonBlocked, status: http.StatusOK},
+		{path: "/broken", reason: pipeline.ReasonHTTPStatus, status: http.StatusInternalServerError},
+		{path: "/gone", reason: pipeline.ReasonClosed, status: http.StatusGone},
+		{path: "/jobs/42", reason: pipeline.ReasonClosed, status: http.StatusOK},
+		{path: "/jobs/43", reason: pipeline.ReasonClosed, status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		response, err = fetcher.Fetch(ctx, server.URL+tt.path)
+		require.Error(t, err, "Fetch of %s should fail", tt.path)
+		require.NotNil(t, response, "Rejected response of %s should be returned", tt.path)
+		assert.Equal(t, tt.status, response.StatusCode)
+		reason, status := pipeline.Classify(err, "")
+		assert.Equal(t, tt.reason, reason, "Unexpected reason for %s", tt.path)
+		assert.Equal(t, tt.status, status, "Unexpected status for %s", tt.path)
+	}
+
+	server.Close()
+	_, err = fetcher.Fetch(ctx, server.URL+"/ok")
+	reason, _ := pipeline.Classify(err, "")
+	assert.Equal(t, pipeline.ReasonNetwork, reason, "Unreachable server should be a network failure")
+}
+
+// TestIsListingRedirect verifies that only redirects to the home page, or to a shorter listing path without a job
+// ID, count as listings.
+func TestIsListingRedirect(t *testing.T) {
+	tests := []struct {
+		requested string
+		final     string
+		listing   bool
+	}{
+		{"https://jobs.example/vacancy/123", "https://jobs.example/vacancy/123", false},
+		{"https://jobs.example/vacancy/123", "https://jobs.example/", true},
+		{"https://jobs.example/vacancy/123", "https://www.jobs.example/vacancies", true},
+		{"https://jobs.example/vacancy/123", "https://jobs.example/vacancy/123-go-developer", false},
+		{"https://jobs.example/it/vacancy/123", "https://jobs.example/vacancy/123", false},
+		{"https://jobs.example/vacancy/123", "https://apply.example/", false},
+		{"https://jobs.example/vacancy/123", "", false},
+		{"https://jobs.example/jobs/python/123", "https://jobs.example/jobs/python", true},
+		{"https://jobs.example/vacancy/123", "https://jobs.example/login", false},
+		{"https://jobs.example/vacancy/123", "https://jobs.example/consent", false},
+		{"https://jobs.example/jobs/python/123", "https://jobs.example/auth/jobs", false},
+		{"https://jobs.example/company/acme/123", "https://jobs.example/company/acme", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.listing, infraHtml.IsListingRedirect(tt.requested, tt.final), "%s -> %s", tt.requested, tt.final)
+	}
+}
+
+// TestIsClosedPage verifies that closed markers count only within the posting, not in widgets listing other
+// postings or past the inspected part of the page.
+func TestIsClosedPage(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		closed bool
+	}{
+		{"marker", "<html><body><p>This job is no longer available</p></body></html>", true},
+		{"container", "<main><h1>Go Developer</h1><p>This position has been   filled.</p></main>", true},
+		{"open", "<main><h1>Go Developer</h1><p>Apply now</p></main>", false},
+		{"related", "<main><h1>Go Developer</h1><aside><p>Вакансія закрита</p></aside></main>", false},
+		{"outside", "<main><h1>Go Developer</h1></main><div class='similar-jobs'>This job has expired</div>", false},
+		{"widget", "<div><h1>Go Developer</h1><div class='related-jobs'>This job has expired</div></div>", false},
+		{"far", "<p>" + strings.Repeat("a", 64*1024) + "</p><p>This job has expired</p>", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.closed, infraHtml.IsClosedPage([]byte(tt.body)), tt.name)
+	}
+}
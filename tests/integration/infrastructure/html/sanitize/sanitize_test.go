@@ -0,0 +1,87 @@
+package sanitize
+
+import (
+	"infrastructure/html/sanitize"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSanitize verifies that only allowed elements and attributes are kept.
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "scripts and styles are removed with their content",
+			input:    `<p>Hello<script>alert(1)</script><style>p{}</style> world</p>`,
+			expected: `<p>Hello world</p>`,
+		},
+		{
+			name:     "tracking pixels and media are removed",
+			input:    `<p>Apply now<img src="https://t.example.com/p.gif" width="1" height="1"></p><iframe src="x"></iframe>`,
+			expected: `<p>Apply now</p>`,
+		},
+		{
+			name:     "attributes are stripped",
+			input:    `<p class="lead" style="color:red" onclick="track()" data-id="1">Text</p>`,
+			expected: `<p>Text</p>`,
+		},
+		{
+			name:     "safe links keep their target",
+			input:    `<a href="https://example.com/apply" target="_blank" rel="x">Apply</a>`,
+			expected: `<a href="https://example.com/apply">Apply</a>`,
+		},
+		{
+			name:     "unsafe links are reduced to text",
+			input:    `<a href="javascript:alert(1)">Apply</a> <a href="/relative">here</a>`,
+			expected: `Apply here`,
+		},
+		{
+			name:     "hidden elements are removed",
+			input:    `<p>Shown</p><p hidden>Hidden</p><div style="display: none">x</div><i aria-hidden="true">x</i>`,
+			expected: `<p>Shown</p>`,
+		},
+		{
+			name:     "containers with text become paragraphs",
+			input:    `<div><span>First</span></div><section><div>Second</div></section>`,
+			expected: `<p>First</p><p>Second</p>`,
+		},
+		{
+			name:     "whitespace is collapsed outside of preformatted text",
+			input:    "<p>Go\n   and   Mongo</p><pre>a\n  b</pre>",
+			expected: "<p>Go and Mongo</p><pre>a\n  b</pre>",
+		},
+		{
+			name:     "empty elements are removed",
+			input:    `<p> </p><strong></strong><p>Text</p>`,
+			expected: `<p>Text</p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sanitize.Sanitize(tt.input))
+		})
+	}
+}
+
+// TestMarkdown verifies the conversion of sanitised HTML into Markdown.
+func TestMarkdown(t *testing.T) {
+	input := `<h3>About <b>us</b></h3><p>We build <a href="https://example.com">things</a> with <em>care</em>.</p>` +
+		`<ul><li><p>Go</p></li><li>Mongo<ul><li>Atlas</li></ul></li></ul><ol><li>one</li><li>two</li></ol>` +
+		`<blockquote><p>Quote</p></blockquote><p>Line<br>break and <code>x := 1</code></p><pre>a\n  b</pre>`
+
+	expected := "### About **us**\n\n" +
+		"We build [things](https://example.com) with _care_.\n\n" +
+		"- Go\n- Mongo\n  - Atlas\n\n" +
+		"1. one\n2. two\n\n" +
+		"> Quote\n\n" +
+		"Line\nbreak and `x := 1`\n\n" +
+		"```\na\\n  b\n```"
+
+	assert.Equal(t, expected, sanitize.Markdown(input))
+	assert.Empty(t, sanitize.Markdown(""), "Empty input should produce empty output")
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"infrastructure/html/schema"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// document loads the given HTML into a goquery document.
+func document(t *testing.T, content string) *goquery.Document {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	require.NoError(t, err, "Failed to load HTML document")
+	return doc
+}
+
+// TestExtract_JSONLD verifies that salary and employment type are read from JSON-LD.
+func TestExtract_JSONLD(t *testing.T) {
+	doc := document(t, `<script type="application/ld+json">
+		[{"@type": "Organization", "name": "Acme"},
+		 {"@type": ["JobPosting"], "title": "Go Developer", "hiringOrganization": "Acme",
+		  "employmentType": "FULL_TIME",
+		  "baseSalary": {"currency": "EUR", "value": {"value": "5000", "unitText": "MONTH"}}}]
+	</script>`)
+
+	posting := schema.Extract(doc)
+	require.NotNil(t, posting, "JobPosting should be found in a JSON-LD list")
+	assert.Equal(t, "Go Developer", posting.Title)
+	assert.Equal(t, "Acme", posting.Company)
+	assert.Equal(t, []string{"FULL_TIME"}, posting.EmploymentType)
+	assert.Equal(t, &schema.Salary{Currency: "EUR", Min: 5000, Max: 5000, Unit: "MONTH"}, posting.Salary)
+}
+
+// TestExtract_Microdata verifies that salary ranges are read from microdata.
+func TestExtract_Microdata(t *testing.T) {
+	doc := document(t, `<div itemscope itemtype="http://schema.org/JobPosting">
+		<span itemprop="title">Go Developer</span>
+		<div itemprop="baseSalary" itemscope itemtype="http://schema.org/MonetaryAmount">
+			<meta itemprop="currency" content="USD">
+			<span itemprop="minValue">90000</span> - <span itemprop="maxValue">120000</span>
+			<meta itemprop="unitText" content="YEAR">
+		</div>
+	</div>`)
+
+	posting := schema.Extract(doc)
+	require.NotNil(t, posting, "JobPosting should be found in microdata")
+	assert.Equal(t, "Go Developer", posting.Title)
+	assert.Equal(t, &schema.Salary{Currency: "USD", Min: 90000, Max: 120000, Unit: "YEAR"}, posting.Salary)
+}
+
+// TestParser_NoJobPosting verifies that a parser without fallback rejects pages without a JobPosting.
+func TestParser_NoJobPosting(t *testing.T) {
+	doc := `<script type="application/ld+json">{"@type": "WebPage"}</script>`
+
+	assert.Nil(t, schema.Extract(document(t, doc)), "WebPage should not be taken for a JobPosting")
+
+	_, err := schema.NewParser(nil).Parse(doc)
+	assert.ErrorIs(t, err, schema.ErrNoJobPosting)
+}
@@ -0,0 +1,6 @@
+package html
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer() *TestContainer {
+	return NewTestContainer()
+}
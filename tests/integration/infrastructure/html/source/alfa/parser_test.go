@@ -20,6 +20,10 @@ func TestParser_ValidHTML(t *testing.T) {
 				<div class="row align-items-center gx-1">
 					<a class="text-reset">Tech Innovations</a>
 				</div>
+				<div class="job-description">
+					<p onclick="track()">Build <b>reliable</b> backend services.</p>
+					<script>track()</script>
+				</div>
 			</body>
 		</html>
 	`
@@ -31,6 +35,9 @@ func TestParser_ValidHTML(t *testing.T) {
 	require.NotNil(t, vacancy, "Vacancy should not be nil for valid HTML")
 	assert.Equal(t, "Backend Developer", vacancy.Title, "Vacancy title should match")
 	assert.Equal(t, "Tech Innovations", vacancy.Company, "Vacancy company should match")
+	assert.Equal(t, "Build **reliable** backend services.", vacancy.Description, "Vacancy description should match")
+	assert.Equal(t, "<p>Build <b>reliable</b> backend services.</p>", vacancy.DescriptionHTML,
+		"Vacancy description HTML should be sanitised")
 }
 
 // TestParser_MissingElements tests parsing HTML with missing elements.
@@ -92,3 +99,12 @@ func TestParser_EmptyHTML(t *testing.T) {
 	assert.Equal(t, "Unknown Title", vacancy.Title, "Vacancy title should default to 'Unknown Title'")
 	assert.Equal(t, "Unknown Company", vacancy.Company, "Vacancy company should default to 'Unknown Company'")
 }
+
+// TestParser_Identity tests that the parser reports its name and version.
+func TestParser_Identity(t *testing.T) {
+	container := SetupTestContainer(t)
+	parser := container.AlfaHtmlParser.Get()
+
+	assert.Equal(t, "alfa", parser.Name(), "Parser name should match")
+	assert.NotEmpty(t, parser.Version(), "Parser version should be set")
+}
@@ -18,10 +18,12 @@ func TestFetcher_FetchValidURL(t *testing.T) {
 	// Fetch the content
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
-	content, err := fetcher.Fetch(ctx, url)
+	response, err := fetcher.Fetch(ctx, url)
 
 	require.NoError(t, err, "Fetcher should not return an error for a valid URL")
-	assert.Contains(t, content, "ip", "Response should contain the key 'ip'")
+	assert.Contains(t, response.Body, "ip", "Response should contain the key 'ip'")
+	assert.Equal(t, 200, response.StatusCode, "Response status should be recorded")
+	assert.False(t, response.FetchedAt.IsZero(), "Fetch time should be recorded")
 }
 
 // TestFetcher_FetchInvalidURL tests fetching an invalid URL.
@@ -35,8 +37,8 @@ func TestFetcher_FetchInvalidURL(t *testing.T) {
 	// Fetch the content
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	content, err := fetcher.Fetch(ctx, url)
+	response, err := fetcher.Fetch(ctx, url)
 
 	require.Error(t, err, "Fetcher should return an error for an invalid URL")
-	assert.Empty(t, content, "Content should be empty for an invalid URL")
+	assert.Nil(t, response, "Response should be nil for an invalid URL")
 }
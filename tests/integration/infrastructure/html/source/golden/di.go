@@ -0,0 +1,32 @@
+package golden
+
+import (
+	"application/dependency"
+	"domain/html"
+	"infrastructure/html/schema"
+	htmlAlfa "infrastructure/html/source/alfa"
+	htmlBeta "infrastructure/html/source/beta"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	Parsers dependency.LazyDependency[[]html.Parser]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	// Every parser listed here must have at least one fixture under testdata/<parser name>.
+	c.Parsers = dependency.LazyDependency[[]html.Parser]{
+		InitFunc: func() []html.Parser {
+			return []html.Parser{
+				schema.NewParser(htmlAlfa.NewParser()),
+				schema.NewParser(htmlBeta.NewParser()),
+				schema.NewParser(nil),
+			}
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,180 @@
+// Package golden pins real pages of every source together with their expected extraction.
+//
+// Fixtures live under testdata/<parser name>/<case>/ and consist of page.html, the raw page, and
+// expected.json, the fields the parser is expected to extract from it. Run the tests with -update
+// to rewrite expected.json from the current parser output, then review the changes with git diff.
+// Pages are parsed as if fetched at FetchedAt, so relative dates resolve to the same posting date on every run.
+package golden
+
+import (
+	"application/url/processor/dto"
+	"bytes"
+	"domain/html"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	pageFile     = "page.html"     // pageFile is the raw page of a fixture.
+	expectedFile = "expected.json" // expectedFile holds the expected extraction of a fixture.
+)
+
+// FetchedAt is the time every fixture page is parsed as fetched at.
+var FetchedAt = time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+// Expected is the extraction pinned by a fixture.
+type Expected struct {
+	ExternalID         string `json:"external_id,omitempty"`
+	Title              string `json:"title"`
+	Company            string `json:"company"`
+	Description        string `json:"description"`
+	DescriptionHTML    string `json:"description_html"`
+	Location           string `json:"location"`
+	PostedAt           string `json:"posted_at"`
+	PostedAtConfidence string `json:"posted_at_confidence"`
+	Salary             string `json:"salary,omitempty"`
+	Seniority          string `json:"seniority,omitempty"`
+	EmploymentType     string `json:"employment_type,omitempty"`
+	WorkMode           string `json:"work_mode,omitempty"`
+}
+
+// NewExpected copies the pinned fields of a parsed vacancy.
+func NewExpected(v *dto.Vacancy) Expected {
+	return Expected{
+		ExternalID:         v.ExternalID,
+		Title:              v.Title,
+		Company:            v.Company,
+		Description:        v.Description,
+		DescriptionHTML:    v.DescriptionHTML,
+		Location:           v.Location,
+		PostedAt:           v.PostedAt.Format(time.RFC3339),
+		PostedAtConfidence: string(v.PostedAtConfidence),
+		Salary:             v.Salary.String(),
+		Seniority:          string(v.Seniority),
+		EmploymentType:     string(v.EmploymentType),
+		WorkMode:           string(v.WorkMode),
+	}
+}
+
+// Fixtures returns the case directories of a parser, sorted by name.
+func Fixtures(root, parser string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(root, parser))
+	if err != nil {
+		return nil, fmt.Errorf("read fixtures of %s: %w", parser, err)
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, filepath.Join(root, parser, entry.Name()))
+		}
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// Diff returns a line per field that differs between the expected and the actual extraction.
+func Diff(expected, actual Expected) []string {
+	var (
+		diffs []string
+		exp   = reflect.ValueOf(expected)
+		act   = reflect.ValueOf(actual)
+	)
+
+	for i := 0; i < exp.NumField(); i++ {
+		name := exp.Type().Field(i).Tag.Get("json")
+		if e, a := exp.Field(i).Interface(), act.Field(i).Interface(); e != a {
+			diffs = append(diffs, fmt.Sprintf("%s:\n\t- %q\n\t+ %q", name, e, a))
+		}
+	}
+	return diffs
+}
+
+// Run checks the parser against every fixture in root, rewriting the expected files when update is set.
+func Run(t *testing.T, parser html.Parser, root string, update bool) {
+	dirs, err := Fixtures(root, parser.Name())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(dirs) == 0 {
+		t.Fatalf("no fixtures for parser %s", parser.Name())
+	}
+
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			actual, err := extract(parser, dir)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+
+			if update {
+				if err = write(dir, actual); err != nil {
+					t.Fatalf("%v", err)
+				}
+				return
+			}
+
+			expected, err := read(dir)
+			if err != nil {
+				t.Fatalf("%v (run with -update to create it)", err)
+			}
+			if diffs := Diff(expected, actual); len(diffs) > 0 {
+				t.Errorf("extraction differs from %s:\n%s", expectedFile, strings.Join(diffs, "\n"))
+			}
+		})
+	}
+}
+
+// extract parses the page of a fixture.
+func extract(parser html.Parser, dir string) (Expected, error) {
+	page, err := os.ReadFile(filepath.Join(dir, pageFile))
+	if err != nil {
+		return Expected{}, fmt.Errorf("read page: %w", err)
+	}
+
+	vacancy, err := parser.ParseAt(string(page), FetchedAt)
+	if err != nil {
+		return Expected{}, fmt.Errorf("parse page: %w", err)
+	}
+	defer vacancy.Release()
+
+	return NewExpected(vacancy), nil
+}
+
+// read loads the expected extraction of a fixture.
+func read(dir string) (Expected, error) {
+	var expected Expected
+
+	data, err := os.ReadFile(filepath.Join(dir, expectedFile))
+	if err != nil {
+		return expected, fmt.Errorf("read expected: %w", err)
+	}
+	if err = json.Unmarshal(data, &expected); err != nil {
+		return expected, fmt.Errorf("decode expected: %w", err)
+	}
+	return expected, nil
+}
+
+// write stores the extraction as the expected output of a fixture.
+func write(dir string, expected Expected) error {
+	var buf bytes.Buffer
+
+	// HTML is kept unescaped, so the expected files stay readable in review.
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(expected); err != nil {
+		return fmt.Errorf("encode expected: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, expectedFile), buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write expected: %w", err)
+	}
+	return nil
+}
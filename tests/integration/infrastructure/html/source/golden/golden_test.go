@@ -0,0 +1,32 @@
+package golden
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// update rewrites the expected files from the current parser output.
+var update = flag.Bool("update", false, "rewrite expected.json of every fixture from the current parser output")
+
+// TestParsers_Golden runs every parser against its fixtures.
+func TestParsers_Golden(t *testing.T) {
+	container := SetupTestContainer(t)
+
+	for _, parser := range container.Parsers.Get() {
+		t.Run(parser.Name(), func(t *testing.T) {
+			Run(t, parser, "testdata", *update)
+		})
+	}
+}
+
+// TestDiff verifies that only differing fields are reported.
+func TestDiff(t *testing.T) {
+	expected := Expected{Title: "Go Developer", Company: "TechCorp", Description: "-", Location: "Berlin"}
+	actual := expected
+	actual.Location = "Remote"
+
+	assert.Equal(t, []string{"location:\n\t- \"Berlin\"\n\t+ \"Remote\""}, Diff(expected, actual))
+	assert.Empty(t, Diff(expected, expected), "Equal extractions should not differ")
+}
@@ -0,0 +1,8 @@
+package golden
+
+import "testing"
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer(t *testing.T) *TestContainer {
+	return NewTestContainer()
+}
@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	"application/dependency"
+	"infrastructure/http/ratelimit"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	Limiter dependency.LazyDependency[*ratelimit.Limiter]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.Limiter = dependency.LazyDependency[*ratelimit.Limiter]{
+		InitFunc: func() *ratelimit.Limiter {
+			fallback := ratelimit.Policy{RequestsPerMinute: 600, Burst: 2, RespectCrawlDelay: true}
+			return ratelimit.NewLimiter(fallback)
+		},
+	}
+
+	return c
+}
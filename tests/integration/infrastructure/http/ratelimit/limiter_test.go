@@ -0,0 +1,186 @@
+package ratelimit
+
+import (
+	"application/url/processor/limiter"
+	"context"
+	"fmt"
+	"infrastructure/http/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLimiter_AllowsBurstThenThrottles verifies that a host gets its burst immediately
+// and further requests are spaced according to the requests per minute.
+func TestLimiter_AllowsBurstThenThrottles(t *testing.T) {
+	container := SetupTestContainer()
+	limiter := container.Limiter.Get()
+	ctx := context.Background()
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "example.com"))
+	require.NoError(t, limiter.Wait(ctx, "example.com"))
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "Burst should not be throttled")
+
+	require.NoError(t, limiter.Wait(ctx, "example.com"))
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond, "Third request should wait for a token")
+}
+
+// TestLimiter_IsolatesHosts verifies that throttling one host does not delay another.
+func TestLimiter_IsolatesHosts(t *testing.T) {
+	container := SetupTestContainer()
+	limiter := container.Limiter.Get()
+	limiter.SetPolicy("slow.example", ratelimit.Policy{RequestsPerMinute: 1, Burst: 1})
+	ctx := context.Background()
+
+	require.NoError(t, limiter.Wait(ctx, "slow.example"))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.Error(t, limiter.Wait(timeoutCtx, "www.slow.example:443"), "Same host should be throttled")
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "fast.example"))
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "Other hosts should not be throttled")
+}
+
+// TestLimiter_InheritsParentPolicy verifies that subdomains get the policy of their parent domain
+// and that the top-level domain is never used as a parent.
+func TestLimiter_InheritsParentPolicy(t *testing.T) {
+	container := SetupTestContainer()
+	limiter := container.Limiter.Get()
+	policy := ratelimit.Policy{RequestsPerMinute: 1, Burst: 1}
+	limiter.SetPolicy("example.com", policy)
+
+	assert.Equal(t, policy, limiter.Policy("jobs.example.com"), "Subdomain should inherit the policy")
+	assert.Equal(t, policy, limiter.Policy("www.eu.jobs.example.com:443"), "Nested subdomain should inherit the policy")
+	assert.NotEqual(t, policy, limiter.Policy("example.org"), "Other domains should use the fallback policy")
+	assert.NotEqual(t, policy, limiter.Policy("notexample.com"), "Similar domains should use the fallback policy")
+}
+
+// TestLimiter_MinDelay verifies that consecutive requests are spaced by at least the minimum delay.
+func TestLimiter_MinDelay(t *testing.T) {
+	container := SetupTestContainer()
+	limiter := container.Limiter.Get()
+	limiter.SetPolicy("example.com", ratelimit.Policy{MinDelay: 100 * time.Millisecond})
+	ctx := context.Background()
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "example.com"))
+	require.NoError(t, limiter.Wait(ctx, "example.com"))
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond, "Second request should respect the minimum delay")
+}
+
+// TestParseCrawlDelay verifies that only the Crawl-delay of the wildcard group is used.
+func TestParseCrawlDelay(t *testing.T) {
+	robots := "User-agent: Googlebot\nCrawl-delay: 1\n\nUser-agent: Bingbot\nUser-agent: *\nDisallow: /private\nCrawl-delay: 2.5 # slow down\n"
+
+	delay, ok := ratelimit.ParseCrawlDelay(strings.NewReader(robots))
+	require.True(t, ok, "Crawl-delay should be found")
+	assert.Equal(t, 2500*time.Millisecond, delay)
+
+	_, ok = ratelimit.ParseCrawlDelay(strings.NewReader("User-agent: *\nDisallow: /\n"))
+	assert.False(t, ok, "Missing Crawl-delay should be reported")
+}
+
+// TestTransport_HonoursCrawlDelay verifies that the transport reads robots.txt once and spaces requests by its Crawl-delay.
+func TestTransport_HonoursCrawlDelay(t *testing.T) {
+	var robotsHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			_, _ = fmt.Fprint(w, "User-agent: *\nCrawl-delay: 0.2\n")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	container := SetupTestContainer()
+	client := ratelimit.WrapClient(server.Client(), container.Limiter.Get())
+
+	start := time.Now()
+	for range 2 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/job", http.NoBody)
+		require.NoError(t, err, "Failed to create HTTP request")
+		resp, err := client.Do(req)
+		require.NoError(t, err, "Failed to execute HTTP request")
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, int32(1), robotsHits.Load(), "robots.txt should be fetched once")
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond, "Requests should be spaced by the Crawl-delay")
+}
+
+// TestTransport_SharesRobotsAcrossClients verifies that clients wrapped by the same transport look up robots.txt once.
+func TestTransport_SharesRobotsAcrossClients(t *testing.T) {
+	var robotsHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			_, _ = fmt.Fprint(w, "User-agent: *\nCrawl-delay: 0.01\n")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	container := SetupTestContainer()
+	transport := ratelimit.NewTransport(server.Client().Transport, container.Limiter.Get())
+
+	for range 2 {
+		client := transport.Wrap(server.Client())
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/job", http.NoBody)
+		require.NoError(t, err, "Failed to create HTTP request")
+		resp, err := client.Do(req)
+		require.NoError(t, err, "Failed to execute HTTP request")
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, int32(1), robotsHits.Load(), "robots.txt should be fetched once for all wrapped clients")
+}
+
+// TestTransport_TakesSlotAfterHostWait verifies that a request waiting for its host's turn does not hold a slot,
+// and that the slot is held until the response body is closed.
+func TestTransport_TakesSlotAfterHostWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	container := SetupTestContainer()
+	hostLimiter := container.Limiter.Get()
+	hostLimiter.SetPolicy(strings.TrimPrefix(server.URL, "http://"), ratelimit.Policy{MinDelay: 300 * time.Millisecond})
+	slots := limiter.NewLimiter(1)
+	transport := ratelimit.NewTransport(server.Client().Transport, hostLimiter)
+	transport.SetSlots(slots)
+	client := transport.Wrap(server.Client())
+
+	get := func() *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/job", http.NoBody)
+		require.NoError(t, err, "Failed to create HTTP request")
+		resp, err := client.Do(req)
+		require.NoError(t, err, "Failed to execute HTTP request")
+		return resp
+	}
+
+	resp := get()
+	assert.Equal(t, 1, slots.InUse(), "Slot should be held until the body is closed")
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, 0, slots.InUse(), "Closing the body should release the slot")
+
+	done := make(chan *http.Response)
+	go func() { done <- get() }()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, slots.InUse(), "Request waiting for its host should not hold a slot")
+
+	resp = <-done
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, 0, slots.InUse(), "Slot should be released")
+}
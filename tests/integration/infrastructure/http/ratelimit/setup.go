@@ -0,0 +1,6 @@
+package ratelimit
+
+// SetupTestContainer initializes the TestContainer.
+func SetupTestContainer() *TestContainer {
+	return NewTestContainer()
+}
@@ -0,0 +1,64 @@
+package language
+
+import (
+	"infrastructure/language"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDetector creates a detector backed by the embedded profiles.
+func newDetector(t *testing.T) *language.Detector {
+	detector, err := language.NewDetector()
+	require.NoError(t, err, "Embedded profiles should be valid")
+	return detector
+}
+
+// TestDetector_Detect verifies the language of short vacancy texts in the supported languages.
+func TestDetector_Detect(t *testing.T) {
+	detector := newDetector(t)
+
+	tests := []struct {
+		title       string
+		description string
+		expected    string
+	}{
+		{"Senior Go Developer", "We are hiring a backend engineer to build our payment platform.", "en"},
+		{"Starszy programista Go", "Szukamy osoby do zespołu, który buduje platformę płatności. Praca zdalna.", "pl"},
+		{"Go Entwickler (m/w/d)", "Für unser Team in Berlin suchen wir ab sofort Verstärkung im Bereich Backend.", "de"},
+		{"Développeur Go", "Rejoignez notre équipe à Paris pour construire la plateforme de paiement.", "fr"},
+		{"Desarrollador Go", "Únete a nuestro equipo en Madrid para construir la plataforma de pagos.", "es"},
+		{"Sviluppatore Go", "Unisciti al nostro team a Milano per costruire la piattaforma di pagamento.", "it"},
+		{"Go ontwikkelaar", "Kom ons team in Amsterdam versterken en bouw mee aan het betaalplatform.", "nl"},
+		{"Programador Go", "Junta-te à nossa equipa em Lisboa para construir a plataforma de pagamentos.", "pt"},
+		{"Go vývojář", "Přidejte se k našemu týmu v Praze a budujte platební platformu.", "cs"},
+		{"Розробник Go", "Приєднуйтесь до нашої команди у Києві та створюйте платіжну платформу.", "uk"},
+		{"Разработчик Go", "Присоединяйтесь к нашей команде в Москве и создавайте платёжную платформу.", "ru"},
+	}
+
+	for _, tt := range tests {
+		code, confidence := detector.Detect(tt.title, tt.description)
+		assert.Equal(t, tt.expected, code, "Language of %q", tt.title)
+		assert.Greater(t, confidence, 0.5, "Confidence of %q", tt.title)
+		assert.LessOrEqual(t, confidence, 1.0, "Confidence of %q", tt.title)
+	}
+}
+
+// TestDetector_DetectShortText verifies that texts too short to tell are left undetected.
+func TestDetector_DetectShortText(t *testing.T) {
+	detector := newDetector(t)
+
+	for _, text := range []string{"", "Go, Kubernetes", "12 000 - 15 000 PLN"} {
+		code, confidence := detector.Detect(text)
+		assert.Empty(t, code, "Language of %q", text)
+		assert.Zero(t, confidence, "Confidence of %q", text)
+	}
+}
+
+// TestDetector_Languages verifies that every embedded profile is loaded.
+func TestDetector_Languages(t *testing.T) {
+	detector := newDetector(t)
+
+	assert.Equal(t, []string{"cs", "de", "en", "es", "fr", "it", "nl", "pl", "pt", "ru", "uk"}, detector.Languages())
+}
@@ -0,0 +1,50 @@
+package run
+
+import (
+	"application/config"
+	"application/dependency"
+	runRepo "domain/run/repository"
+	"fmt"
+	infraMongo "infrastructure/mongo"
+	"infrastructure/run"
+	"log"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	Config        dependency.LazyDependency[*config.Config]
+	MongoClient   dependency.LazyDependency[*mongo.Client]
+	RunRepository dependency.LazyDependency[runRepo.RunRepository]
+}
+
+// NewTestContainer initializes a new test container.
+func NewTestContainer() *TestContainer {
+	c := &TestContainer{}
+
+	c.Config = dependency.LazyDependency[*config.Config]{
+		InitFunc: config.LoadConfig,
+	}
+	c.MongoClient = dependency.LazyDependency[*mongo.Client]{
+		InitFunc: func() *mongo.Client {
+			cfg := c.Config.Get()
+			uri := fmt.Sprintf("mongodb://%s:%s@%s:%s", cfg.Mongo.User, cfg.Mongo.Pass, cfg.Mongo.Host, cfg.Mongo.Port)
+			mongoClient, err := infraMongo.NewMongoClient(uri)
+			if err != nil {
+				log.Fatalf("mongo client error: %v", err)
+			}
+			return mongoClient
+		},
+	}
+	c.RunRepository = dependency.LazyDependency[runRepo.RunRepository]{
+		InitFunc: func() runRepo.RunRepository {
+			mongoClient := c.MongoClient.Get()
+			cfg := c.Config.Get()
+			collection := mongoClient.Database(cfg.Mongo.DB).Collection(cfg.Mongo.RunsCollection)
+			return run.NewRepository(mongoClient, collection)
+		},
+	}
+
+	return c
+}
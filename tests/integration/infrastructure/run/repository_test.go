@@ -0,0 +1,38 @@
+package run
+
+import (
+	"context"
+	"domain/run/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepository_SaveAndFetchLatest validates that saved run reports are returned newest first.
+func TestRepository_SaveAndFetchLatest(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.RunRepository.Get()
+
+	ctx := context.Background()
+	start := time.Now().Add(-time.Hour)
+	for i := range 3 {
+		run := &entity.Run{
+			StartedAt:  start.Add(time.Duration(i) * time.Minute),
+			FinishedAt: start.Add(time.Duration(i+1) * time.Minute),
+			Sources: []entity.SourceStats{
+				{Name: "alfa", Discovered: i, Failed: map[string]int{"fetch": i}},
+			},
+		}
+		require.NoError(t, repo.Save(ctx, run), "Failed to save run report")
+		assert.False(t, run.ID.IsZero(), "ID should be assigned on save")
+	}
+
+	runs, err := repo.FetchLatest(ctx, 2)
+	require.NoError(t, err, "Failed to fetch run reports")
+	require.Len(t, runs, 2, "Unexpected number of results")
+	assert.Equal(t, 2, runs[0].Sources[0].Discovered, "Newest run should come first")
+	assert.Equal(t, 1, runs[1].Sources[0].Discovered, "Runs should be sorted by start time")
+	assert.Equal(t, map[string]int{"fetch": 2}, runs[0].Sources[0].Failed, "Failures are not as expected")
+}
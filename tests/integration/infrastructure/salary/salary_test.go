@@ -0,0 +1,99 @@
+package salary
+
+import (
+	"domain/vacancy/entity"
+	"infrastructure/salary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse verifies the extraction of amounts, currency, period, basis and contract from vacancy text.
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected entity.Salary
+	}{
+		{
+			text: "10 000 – 15 000 PLN net/month (B2B)",
+			expected: entity.Salary{Min: 10000, Max: 15000, Currency: "PLN", Period: entity.SalaryPeriodMonth,
+				Basis: entity.SalaryBasisNet, Contract: entity.SalaryContractB2B},
+		},
+		{
+			text: "Wynagrodzenie: 12.000 - 16.000 zł brutto miesięcznie, umowa o pracę",
+			expected: entity.Salary{Min: 12000, Max: 16000, Currency: "PLN", Period: entity.SalaryPeriodMonth,
+				Basis: entity.SalaryBasisGross, Contract: entity.SalaryContractPermanent},
+		},
+		{
+			text:     "We pay $90,000 to $120,000 per year.",
+			expected: entity.Salary{Min: 90000, Max: 120000, Currency: "USD", Period: entity.SalaryPeriodYear},
+		},
+		{
+			text: "Rate: 120-150 PLN/h netto + VAT",
+			expected: entity.Salary{Min: 120, Max: 150, Currency: "PLN", Period: entity.SalaryPeriodHour,
+				Basis: entity.SalaryBasisNet},
+		},
+		{
+			text:     "Salary 12-18k PLN",
+			expected: entity.Salary{Min: 12000, Max: 18000, Currency: "PLN", Period: entity.SalaryPeriodMonth},
+		},
+		{
+			text:     "Up to €80k",
+			expected: entity.Salary{Min: 80000, Max: 80000, Currency: "EUR", Period: entity.SalaryPeriodYear},
+		},
+		{
+			text:     "Salary 18-22k PLN/month",
+			expected: entity.Salary{Min: 18000, Max: 22000, Currency: "PLN", Period: entity.SalaryPeriodMonth},
+		},
+		{
+			text:     "budget 2000 EUR for training, salary 5000 EUR",
+			expected: entity.Salary{Min: 5000, Max: 5000, Currency: "EUR", Period: entity.SalaryPeriodMonth},
+		},
+		{
+			text:     "Gehalt ab 65.000 € pro Jahr",
+			expected: entity.Salary{Min: 65000, Max: 65000, Currency: "EUR", Period: entity.SalaryPeriodYear},
+		},
+		{
+			text:     "Senior .NET developer, 5 years of experience, 800 EUR/day",
+			expected: entity.Salary{Min: 800, Max: 800, Currency: "EUR", Period: entity.SalaryPeriodDay},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			s := salary.Parse(tt.text)
+			require.NotNil(t, s, "Salary should be found")
+			assert.Equal(t, tt.expected, *s)
+		})
+	}
+}
+
+// TestParse_None verifies that numbers without a currency, and amounts without any salary context, are not
+// taken for a salary.
+func TestParse_None(t *testing.T) {
+	for _, text := range []string{"", "3+ years of Go, team of 10 000 engineers", "Founded in 2015",
+		"Version 1.22 EUR", "Training budget 2000 EUR"} {
+		assert.Nil(t, salary.Parse(text), "No salary should be found in %q", text)
+	}
+}
+
+// TestNormalizer_Normalize verifies the conversion into an annual amount in the reference currency.
+func TestNormalizer_Normalize(t *testing.T) {
+	normalizer := salary.NewNormalizer("EUR", map[string]float64{"pln": 0.25})
+
+	s := &entity.Salary{Min: 10000, Max: 15000, Currency: "PLN", Period: entity.SalaryPeriodMonth}
+	normalizer.Normalize(s)
+	assert.Equal(t, 30000.0, s.AnnualMin)
+	assert.Equal(t, 45000.0, s.AnnualMax)
+	assert.Equal(t, "EUR", s.AnnualCurrency)
+
+	s = &entity.Salary{Min: 50, Max: 50, Currency: "EUR", Period: entity.SalaryPeriodHour}
+	normalizer.Normalize(s)
+	assert.Equal(t, 104000.0, s.AnnualMin, "Reference currency should not need a rate")
+
+	s = &entity.Salary{Min: 5000, Max: 5000, Currency: "SEK", Period: entity.SalaryPeriodMonth, AnnualMin: 1}
+	normalizer.Normalize(s)
+	assert.Zero(t, s.AnnualMin, "Currencies without a rate should not be converted")
+	assert.Empty(t, s.AnnualCurrency)
+}
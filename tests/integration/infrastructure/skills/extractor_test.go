@@ -0,0 +1,59 @@
+package skills
+
+import (
+	"infrastructure/skills"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newExtractor creates an extractor backed by the embedded taxonomy.
+func newExtractor(t *testing.T) *skills.Extractor {
+	taxonomy, err := skills.Load("")
+	require.NoError(t, err, "Embedded taxonomy should be valid")
+	return skills.NewExtractor(taxonomy)
+}
+
+// TestExtractor_Extract verifies that aliases are normalised and ambiguous words need evidence.
+func TestExtractor_Extract(t *testing.T) {
+	extractor := newExtractor(t)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"aliases", "We run Golang services on K8s with Postgres.", []string{"go", "kubernetes", "postgresql"}},
+		{"version", "Go 1.22, generics and the new loop semantics", []string{"go"}},
+		{"context word", "Senior Go Developer", []string{"go"}},
+		{"enumeration", "Stack: Go, Kafka and Redis", []string{"go", "kafka", "redis"}},
+		{"english verb", "Ready to go? Let's go to the next level. Go ahead and apply!", []string{}},
+		{"lowercase", "you will go with docker", []string{"docker"}},
+		{"symbols", "C#, C++ and .NET Core; no plain C here: see section C.", []string{"cpp", "csharp", "dotnet"}},
+		{"longest alias", "Spring Boot microservices, hiring in spring", []string{"microservices", "spring"}},
+		{"substring", "postgresql, mongodb, javascript", []string{"javascript", "mongodb", "postgresql"}},
+		{"ci/cd", "Own the CI/CD pipelines in GitLab", []string{"ci-cd", "git"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, extractor.Extract(tt.text))
+		})
+	}
+}
+
+// TestExtractor_Extract_Texts verifies that the tags of several texts are merged.
+func TestExtractor_Extract_Texts(t *testing.T) {
+	tags := newExtractor(t).Extract("Backend Engineer (Golang)", "Experience with gRPC and Kubernetes.")
+	assert.Equal(t, []string{"go", "grpc", "kubernetes"}, tags)
+}
+
+// TestParse_Invalid verifies that malformed taxonomies are rejected.
+func TestParse_Invalid(t *testing.T) {
+	_, err := skills.Parse([]byte(`{"skills": [{"tag": "go"}]}`))
+	assert.ErrorContains(t, err, "has no aliases")
+
+	_, err = skills.Parse([]byte(`{"skills": [{"aliases": ["go"]}]}`))
+	assert.ErrorContains(t, err, "skill without tag")
+}
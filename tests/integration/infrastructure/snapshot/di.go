@@ -0,0 +1,25 @@
+package snapshot
+
+import (
+	"application/dependency"
+	snapshotRepo "domain/snapshot/repository"
+	"infrastructure/snapshot"
+)
+
+// TestContainer holds dependencies for the integration tests.
+type TestContainer struct {
+	SnapshotStore dependency.LazyDependency[snapshotRepo.SnapshotRepository]
+}
+
+// NewTestContainer initializes a new test container storing snapshots under dir.
+func NewTestContainer(dir string) *TestContainer {
+	c := &TestContainer{}
+
+	c.SnapshotStore = dependency.LazyDependency[snapshotRepo.SnapshotRepository]{
+		InitFunc: func() snapshotRepo.SnapshotRepository {
+			return snapshot.NewRepository(dir)
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,111 @@
+package snapshot
+
+import (
+	"context"
+	"domain/snapshot/entity"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshot returns a snapshot of a page with the given body.
+func newSnapshot(body string) *entity.Snapshot {
+	return &entity.Snapshot{
+		URL:        "https://example.com/job/1",
+		FinalURL:   "https://www.example.com/job/1",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		FetchedAt:  time.Now().UTC().Truncate(time.Second),
+		Body:       body,
+	}
+}
+
+// TestRepository_SaveAndFind validates that a saved snapshot can be read back unchanged.
+func TestRepository_SaveAndFind(t *testing.T) {
+	container := SetupTestContainer(t)
+	store := container.SnapshotStore.Get()
+	ctx := context.Background()
+
+	snapshot := newSnapshot("<html><title>Go Developer</title></html>")
+	require.NoError(t, store.Save(ctx, snapshot), "Failed to save snapshot")
+	require.Len(t, snapshot.ID, 64, "ID should be the SHA-256 of the URL and body")
+
+	result, err := store.FindByID(ctx, snapshot.ID)
+	require.NoError(t, err, "Failed to find snapshot")
+	assert.Equal(t, snapshot, result, "Snapshot is not as expected")
+}
+
+// TestRepository_ContentAddressed validates that identical pages share an ID, while different bodies
+// and identical bodies of different URLs do not.
+func TestRepository_ContentAddressed(t *testing.T) {
+	container := SetupTestContainer(t)
+	store := container.SnapshotStore.Get()
+	ctx := context.Background()
+
+	first, second, other := newSnapshot("same"), newSnapshot("same"), newSnapshot("other")
+	elsewhere := newSnapshot("same")
+	elsewhere.URL = "https://example.com/job/2"
+	for _, snapshot := range []*entity.Snapshot{first, second, other, elsewhere} {
+		require.NoError(t, store.Save(ctx, snapshot))
+	}
+
+	assert.Equal(t, first.ID, second.ID, "Identical pages should share an ID")
+	assert.NotEqual(t, first.ID, other.ID, "Different bodies should have different IDs")
+	assert.NotEqual(t, first.ID, elsewhere.ID, "Identical bodies of different URLs should have different IDs")
+
+	result, err := store.FindByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.URL, result.URL, "A snapshot should keep the metadata of its own URL")
+
+	_, err = store.FindByID(ctx, "../../etc/passwd")
+	assert.Error(t, err, "Invalid IDs should be rejected")
+}
+
+// TestRepository_Purge validates that only snapshots stored before the cut-off are removed.
+func TestRepository_Purge(t *testing.T) {
+	container := SetupTestContainer(t)
+	store := container.SnapshotStore.Get()
+	ctx := context.Background()
+
+	snapshot := newSnapshot("<html></html>")
+	require.NoError(t, store.Save(ctx, snapshot))
+
+	removed, err := store.Purge(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err, "Failed to purge snapshots")
+	assert.Zero(t, removed, "Recent snapshots should be kept")
+
+	removed, err = store.Purge(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err, "Failed to purge snapshots")
+	assert.Equal(t, 1, removed, "Expired snapshots should be removed")
+
+	_, err = store.FindByID(ctx, snapshot.ID)
+	assert.Error(t, err, "Purged snapshot should no longer be found")
+}
+
+// TestRepository_PurgeStrayTemp validates that temporary files left behind by failed saves are removed
+// without being counted as snapshots.
+func TestRepository_PurgeStrayTemp(t *testing.T) {
+	dir := t.TempDir()
+	store := NewTestContainer(dir).SnapshotStore.Get()
+	ctx := context.Background()
+
+	snapshot := newSnapshot("<html></html>")
+	require.NoError(t, store.Save(ctx, snapshot))
+	stray := filepath.Join(dir, snapshot.ID[:2], snapshot.ID+".123.tmp")
+	require.NoError(t, os.WriteFile(stray, []byte("partial"), 0o600))
+
+	removed, err := store.Purge(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err, "Failed to purge snapshots")
+	assert.Zero(t, removed)
+	assert.FileExists(t, stray, "Recent temporary files may still be written and should be kept")
+
+	removed, err = store.Purge(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err, "Failed to purge snapshots")
+	assert.Equal(t, 1, removed, "Only snapshots should be counted")
+	assert.NoFileExists(t, stray, "Stray temporary files should be removed")
+}
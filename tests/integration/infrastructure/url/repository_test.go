@@ -20,6 +20,7 @@ func TestRepository_Save(t *testing.T) {
 	url := &entity.Url{
 		ID:      primitive.NewObjectID(),
 		Address: "https://example.com",
+		Source:  "alfa",
 		Status:  "pending",
 	}
 
@@ -29,7 +30,7 @@ func TestRepository_Save(t *testing.T) {
 
 	// Verify the entity exists in the database
 	var result []*entity.Url
-	result, err = repo.FetchBatch(ctx, "pending", 1)
+	result, err = repo.FetchBatch(ctx, "alfa", "pending", 1)
 	require.NoError(t, err, "Failed to fetch batch")
 	require.Len(t, result, 1, "Unexpected number of results")
 	assert.Equal(t, url.Address, result[0].Address, "Address is not as expected")
@@ -44,9 +45,10 @@ func TestRepository_FetchBatch(t *testing.T) {
 
 	ctx := context.Background()
 	testData := []*entity.Url{
-		{ID: primitive.NewObjectID(), Address: "https://example1.com", Status: "pending"},
-		{ID: primitive.NewObjectID(), Address: "https://example2.com", Status: "pending"},
-		{ID: primitive.NewObjectID(), Address: "https://example3.com", Status: "completed"},
+		{ID: primitive.NewObjectID(), Address: "https://example1.com", Source: "alfa", Status: "pending"},
+		{ID: primitive.NewObjectID(), Address: "https://example2.com", Source: "alfa", Status: "pending"},
+		{ID: primitive.NewObjectID(), Address: "https://example3.com", Source: "alfa", Status: "completed"},
+		{ID: primitive.NewObjectID(), Address: "https://example4.com", Source: "beta", Status: "pending"},
 	}
 
 	// Seed the database with test data
@@ -55,12 +57,13 @@ func TestRepository_FetchBatch(t *testing.T) {
 		require.NoError(t, err, "Failed to save URL entity")
 	}
 
-	// Fetch a batch of URLs with status "pending"
-	results, err := repo.FetchBatch(ctx, "pending", 2)
+	// Fetch a batch of URLs of the "alfa" source with status "pending"
+	results, err := repo.FetchBatch(ctx, "alfa", "pending", 3)
 	require.NoError(t, err, "Failed to fetch batch")
 	assert.Len(t, results, 2, "Unexpected number of results")
 	for _, result := range results {
 		assert.Equal(t, "pending", result.Status, "Status is not as expected")
+		assert.Equal(t, "alfa", result.Source, "Source is not as expected")
 	}
 }
 
@@ -74,6 +77,7 @@ func TestRepository_UpdateStatus(t *testing.T) {
 	testUrl := &entity.Url{
 		ID:      primitive.NewObjectID(),
 		Address: "https://example.com",
+		Source:  "alfa",
 		Status:  "pending",
 	}
 	err := repo.Save(ctx, testUrl)
@@ -86,9 +90,145 @@ func TestRepository_UpdateStatus(t *testing.T) {
 	require.NoError(t, err, "Failed to update status")
 
 	// Verify the update
-	results, err := repo.FetchBatch(ctx, "completed", 1)
+	results, err := repo.FetchBatch(ctx, "alfa", "completed", 1)
 	require.NoError(t, err, "Failed to fetch batch")
 	assert.Len(t, results, 1, "Unexpected number of results")
 	assert.Equal(t, newStatus, results[0].Status, "Status is not as expected")
 	assert.Equal(t, testUrl.ID, results[0].ID, "ID is not as expected")
 }
+
+// TestRepository_SaveIfNew validates that a URL is only inserted once per address.
+func TestRepository_SaveIfNew(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.UrlRepository.Get()
+
+	ctx := context.Background()
+	url := &entity.Url{Address: "https://example.com/job/1", Source: "alfa", Status: "pending"}
+
+	created, err := repo.SaveIfNew(ctx, url)
+	require.NoError(t, err, "Failed to save URL entity")
+	assert.True(t, created, "First save should insert the URL")
+	assert.False(t, url.ID.IsZero(), "ID should be assigned on insert")
+
+	duplicate := &entity.Url{Address: url.Address, Source: "alfa", Status: "pending"}
+	created, err = repo.SaveIfNew(ctx, duplicate)
+	require.NoError(t, err, "Failed to save duplicate URL entity")
+	assert.False(t, created, "Known address should not be inserted again")
+
+	results, err := repo.FetchBatch(ctx, "alfa", "pending", 10)
+	require.NoError(t, err, "Failed to fetch batch")
+	assert.Len(t, results, 1, "Duplicate URL should not be stored")
+
+	// URLs stored before they were scoped by source are given the source they are found in again.
+	legacy := &entity.Url{ID: primitive.NewObjectID(), Address: "https://example.com/job/2", Status: "pending"}
+	require.NoError(t, repo.Save(ctx, legacy), "Failed to save legacy URL entity")
+	created, err = repo.SaveIfNew(ctx, &entity.Url{Address: legacy.Address, Source: "alfa", Status: "pending"})
+	require.NoError(t, err)
+	assert.False(t, created, "Legacy URL should not be inserted again")
+
+	results, err = repo.FetchBatch(ctx, "alfa", "pending", 10)
+	require.NoError(t, err, "Failed to fetch batch")
+	assert.Len(t, results, 2, "Legacy URL should be picked up by its source")
+}
+
+// TestRepository_MarkFailed validates that a failed URL records its failure reason and HTTP status.
+func TestRepository_MarkFailed(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.UrlRepository.Get()
+
+	ctx := context.Background()
+	testUrl := &entity.Url{Address: "https://example.com/job/2", Source: "alfa", Status: "pending"}
+	require.NoError(t, repo.Save(ctx, testUrl), "Failed to save URL entity")
+
+	processedTime := time.Now()
+	err := repo.MarkFailed(ctx, testUrl.ID.Hex(), "blocked", 403, &processedTime)
+	require.NoError(t, err, "Failed to mark URL as failed")
+
+	results, err := repo.FetchBatch(ctx, "alfa", "failed", 1)
+	require.NoError(t, err, "Failed to fetch batch")
+	require.Len(t, results, 1, "Unexpected number of results")
+	assert.Equal(t, "blocked", results[0].FailureReason, "Failure reason is not as expected")
+	assert.Equal(t, 403, results[0].HTTPStatus, "HTTP status is not as expected")
+}
+
+// TestRepository_MarkMissing validates that URLs missing from consecutive runs are delisted, and relisted when
+// they come back.
+func TestRepository_MarkMissing(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.UrlRepository.Get()
+
+	ctx := context.Background()
+	testData := []*entity.Url{
+		{ID: primitive.NewObjectID(), Address: "https://example1.com", Source: "beta", Status: "success"},
+		{ID: primitive.NewObjectID(), Address: "https://example2.com", Source: "beta", Status: "pending"},
+		{ID: primitive.NewObjectID(), Address: "https://example3.com", Source: "beta", Status: "closed"},
+		{ID: primitive.NewObjectID(), Address: "https://example4.com", Source: "alfa", Status: "pending"},
+	}
+	for _, url := range testData {
+		require.NoError(t, repo.Save(ctx, url), "Failed to save URL entity")
+	}
+
+	// The first URL stays in the sitemap, the others are missing from two runs.
+	run := time.Now().Truncate(time.Millisecond)
+	for i := range 2 {
+		seenAt := run.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, repo.MarkSeen(ctx, "beta", []string{"https://example1.com"}, seenAt))
+
+		delisted, err := repo.MarkMissing(ctx, "beta", seenAt, 2)
+		require.NoError(t, err, "Failed to mark missing URLs")
+		if i == 0 {
+			assert.Empty(t, delisted, "URLs should not be delisted before they miss enough runs")
+			continue
+		}
+		require.Len(t, delisted, 1, "Only the pending URL of the source should be delisted")
+		assert.Equal(t, "https://example2.com", delisted[0].Address)
+	}
+
+	delisted, err := repo.FetchBatch(ctx, "beta", "delisted", 10)
+	require.NoError(t, err)
+	require.Len(t, delisted, 1)
+	assert.Equal(t, 2, delisted[0].Misses)
+
+	// A delisted URL found again is made pending, so that it is fetched anew.
+	require.NoError(t, repo.MarkSeen(ctx, "beta", []string{"https://example2.com"}, run.Add(2*time.Hour)))
+	pending, err := repo.FetchBatch(ctx, "beta", "pending", 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Zero(t, pending[0].Misses, "Misses should be reset")
+}
+
+// TestRepository_Requeue verifies that only processed URLs of the source older than the given time are made pending.
+func TestRepository_Requeue(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.UrlRepository.Get()
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+	testData := []*entity.Url{
+		{ID: primitive.NewObjectID(), Address: "https://example1.com", Source: "beta", Status: "success",
+			Processed: now.Add(-48 * time.Hour)},
+		{ID: primitive.NewObjectID(), Address: "https://example2.com", Source: "beta", Status: "failed",
+			Processed: now.Add(-48 * time.Hour), FailureReason: "network"},
+		{ID: primitive.NewObjectID(), Address: "https://example3.com", Source: "beta", Status: "success",
+			Processed: now.Add(-time.Hour)},
+		{ID: primitive.NewObjectID(), Address: "https://example4.com", Source: "beta", Status: "closed",
+			Processed: now.Add(-48 * time.Hour)},
+		{ID: primitive.NewObjectID(), Address: "https://example5.com", Source: "alfa", Status: "success",
+			Processed: now.Add(-48 * time.Hour)},
+	}
+	for _, url := range testData {
+		require.NoError(t, repo.Save(ctx, url), "Failed to save URL entity")
+	}
+
+	requeued, err := repo.Requeue(ctx, "beta", now.Add(-24*time.Hour))
+	require.NoError(t, err, "Failed to requeue URLs")
+	assert.Equal(t, 2, requeued, "Only the old successful and failed URLs of the source should be requeued")
+
+	pending, err := repo.FetchBatch(ctx, "beta", "pending", 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	for _, url := range pending {
+		assert.Contains(t, []string{"https://example1.com", "https://example2.com"}, url.Address)
+		assert.Empty(t, url.FailureReason, "The failure of the previous attempt should be cleared")
+	}
+}
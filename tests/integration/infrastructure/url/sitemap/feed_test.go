@@ -0,0 +1,40 @@
+package sitemap
+
+import (
+	"infrastructure/url/sitemap/parser"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRssFeed_ParseEntries validates that item publication dates are kept alongside the links.
+func TestRssFeed_ParseEntries(t *testing.T) {
+	feed := parser.NewRssFeed()
+
+	xmlContent := `
+		<rss version="2.0">
+			<channel>
+				<item>
+					<link>https://example.com/job/1</link>
+					<pubDate>Tue, 12 Mar 2024 09:30:00 GMT</pubDate>
+				</item>
+				<item>
+					<link>https://example.com/job/2</link>
+				</item>
+			</channel>
+		</rss>
+	`
+
+	entries, err := feed.ParseEntries(strings.NewReader(xmlContent))
+	require.NoError(t, err, "Feed failed to process valid RSS")
+	require.Len(t, entries, 2, "Every item should produce an entry")
+
+	assert.Equal(t, "https://example.com/job/1", entries[0].URL)
+	assert.True(t, time.Date(2024, time.March, 12, 9, 30, 0, 0, time.UTC).Equal(entries[0].PublishedAt),
+		"Publication date should be parsed, got %s", entries[0].PublishedAt)
+	assert.Equal(t, "https://example.com/job/2", entries[1].URL)
+	assert.True(t, entries[1].PublishedAt.IsZero(), "Items without pubDate should have no publication date")
+}
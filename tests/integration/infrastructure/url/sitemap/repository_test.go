@@ -23,13 +23,19 @@ func TestRepository_SaveUrls_Valid(t *testing.T) {
 	}
 
 	// Save the URLs.
-	err := sitemapRepo.SaveUrls(ctx, urls)
+	saved, err := sitemapRepo.SaveUrls(ctx, "beta", urls)
 	require.NoError(t, err, "Repository should save valid URLs without errors")
+	assert.Equal(t, len(urls), saved, "All URLs should be new")
+
+	// Saving the same URLs again should not store duplicates.
+	saved, err = sitemapRepo.SaveUrls(ctx, "beta", urls)
+	require.NoError(t, err, "Repository should skip known URLs without errors")
+	assert.Zero(t, saved, "Known URLs should not be saved again")
 
 	// Verify that the URLs are stored in the database.
 	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	list, err := urlRepo.FetchBatch(ctx, "pending", 2)
+	list, err := urlRepo.FetchBatch(ctx, "beta", "pending", 3)
 	require.NoError(t, err, "Repository should fetch URLs without errors")
 	assert.Len(t, list, len(urls), "Number of saved URLs does not match input")
 }
@@ -45,13 +51,13 @@ func TestRepository_SaveUrls_Empty(t *testing.T) {
 	var urls []string
 
 	// Save an empty list of URLs.
-	err := sitemapRepo.SaveUrls(ctx, urls)
+	_, err := sitemapRepo.SaveUrls(ctx, "beta", urls)
 	require.NoError(t, err, "Repository should handle empty URL list without errors")
 
 	// Verify that no new URLs are stored in the database.
 	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	list, err := urlRepo.FetchBatch(ctx, "pending", 2)
+	list, err := urlRepo.FetchBatch(ctx, "beta", "pending", 2)
 	require.NoError(t, err, "Repository should not return errors")
 	assert.Len(t, list, len(urls), "Number of saved URLs does not match input")
 }
@@ -128,3 +128,84 @@ func TestRepository_FindByID(t *testing.T) {
 	assert.Equal(t, testVacancy.Location, result.Location, "Location is not as expected")
 	assert.WithinDuration(t, testVacancy.PostedAt, result.PostedAt, time.Second, "PostedAt timestamp mismatch")
 }
+
+// TestRepository_FetchBatch_SkipsQuarantined validates that quarantined vacancies are never fetched for sending.
+func TestRepository_FetchBatch_SkipsQuarantined(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+
+	ctx := context.Background()
+	valid := &entity.Vacancy{Title: "Go Developer", Company: "TechCorp", PostedAt: time.Now()}
+	quarantined := &entity.Vacancy{Title: "Unknown Title", Company: "TechCorp", PostedAt: time.Now()}
+	quarantined.Quarantine("title is a placeholder")
+
+	require.NoError(t, repo.Save(ctx, valid), "Failed to save vacancy")
+	require.NoError(t, repo.Save(ctx, quarantined), "Failed to save quarantined vacancy")
+
+	results, err := repo.FetchBatch(ctx, 10)
+	require.NoError(t, err, "Failed to fetch batch")
+	require.Len(t, results, 1, "Quarantined vacancy should be skipped")
+	assert.Equal(t, valid.ID, results[0].ID, "ID is not as expected")
+}
+
+// TestRepository_FetchOutdated validates that only vacancies of the parser with another version are fetched,
+// including vacancies stored before their parser was recorded.
+func TestRepository_FetchOutdated(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+
+	ctx := context.Background()
+	hosts := []string{"alfa.example"}
+	first := &entity.Vacancy{Title: "Go Developer", Parser: "alfa", ParserVersion: "1"}
+	second := &entity.Vacancy{Title: "Rust Developer", Parser: "alfa", ParserVersion: "1"}
+	current := &entity.Vacancy{Title: "Java Developer", Parser: "alfa", ParserVersion: "2"}
+	other := &entity.Vacancy{Title: "Python Developer", Parser: "beta", ParserVersion: "1"}
+	named := &entity.Vacancy{Title: "C Developer", SourceName: "alfa"}
+	hosted := &entity.Vacancy{Title: "Ruby Developer", SourceURL: "https://www.alfa.example/jobs/1"}
+	foreign := &entity.Vacancy{Title: "PHP Developer", SourceURL: "https://alfa.example.org/jobs/1"}
+	for _, v := range []*entity.Vacancy{first, second, current, other, named, hosted, foreign} {
+		require.NoError(t, repo.Save(ctx, v), "Failed to save vacancy")
+	}
+
+	results, err := repo.FetchOutdated(ctx, "alfa", "2", hosts, "", 1)
+	require.NoError(t, err, "Failed to fetch outdated vacancies")
+	require.Len(t, results, 1, "Limit should be respected")
+	assert.Equal(t, first.ID, results[0].ID, "ID is not as expected")
+
+	results, err = repo.FetchOutdated(ctx, "alfa", "2", hosts, results[0].ID.Hex(), 10)
+	require.NoError(t, err, "Failed to fetch outdated vacancies")
+	require.Len(t, results, 3, "Only vacancies after the cursor should be fetched")
+	assert.Equal(t, second.ID, results[0].ID, "ID is not as expected")
+	assert.Equal(t, named.ID, results[1].ID, "Vacancy without parser should be matched by its source name")
+	assert.Equal(t, hosted.ID, results[2].ID, "Vacancy without parser should be matched by its host")
+
+	results, err = repo.FetchOutdated(ctx, "alfa", "", nil, "", 10)
+	require.NoError(t, err, "Failed to fetch vacancies")
+	assert.Len(t, results, 4, "Empty version should fetch every vacancy of the parser")
+}
+
+// TestRepository_ReleaseCluster validates that only the open duplicates skipped in favour of another member
+// of the cluster are flagged to be sent again.
+func TestRepository_ReleaseCluster(t *testing.T) {
+	container := SetupTestContainer(t)
+	repo := container.VacancyRepository.Get()
+
+	ctx := context.Background()
+	sentAt := time.Now()
+	canonical := &entity.Vacancy{Title: "Go Developer", ClusterID: "c1", SentAt: sentAt, ClosedAt: sentAt}
+	skipped := &entity.Vacancy{Title: "Go Developer", ClusterID: "c1", SentAt: sentAt}
+	published := &entity.Vacancy{Title: "Go Developer", ClusterID: "c1", SentAt: sentAt, RemoteID: 7}
+	other := &entity.Vacancy{Title: "Go Developer", ClusterID: "c2", SentAt: sentAt}
+	for _, vacancy := range []*entity.Vacancy{canonical, skipped, published, other} {
+		require.NoError(t, repo.Save(ctx, vacancy), "Failed to save vacancy")
+	}
+
+	released, err := repo.ReleaseCluster(ctx, "c1")
+	require.NoError(t, err, "Failed to release cluster")
+	assert.Equal(t, 1, released, "Only the skipped duplicate should be released")
+
+	results, err := repo.FetchBatch(ctx, 10)
+	require.NoError(t, err, "Failed to fetch batch")
+	require.Len(t, results, 1, "Released duplicate should be fetched for sending")
+	assert.Equal(t, skipped.ID, results[0].ID, "ID is not as expected")
+}